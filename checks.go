@@ -4,8 +4,25 @@
 package slicelib
 
 var (
-	_ Slicer[any] = (*Slice[any])(nil)
-	_ Slicer[any] = (*LinkedList[any])(nil)
-	_ Slicer[int] = (*ComparableSlice[int])(nil)
-	_ Slicer[int] = (*OrderedSlice[int])(nil)
+	_ Slicer[any]    = (*Slice[any])(nil)
+	_ Slicer[any]    = (*LinkedList[any])(nil)
+	_ Slicer[int]    = (*ComparableSlice[int])(nil)
+	_ Slicer[int]    = (*OrderedSlice[int])(nil)
+	_ Slicer[int]    = (*NumericSlice[int])(nil)
+	_ Slicer[any]    = (*UnrolledList[any])(nil)
+	_ Slicer[any]    = (*GapBuffer[any])(nil)
+	_ Slicer[any]    = (*COWSlice[any])(nil)
+	_ Slicer[int]    = (*IndexedSlice[int])(nil)
+	_ Slicer[string] = (*StringSlice)(nil)
+)
+
+var (
+	_ ReadSlicer[any] = (*PVector[any])(nil)
+	_ ReadSlicer[any] = (*ImmutableSlice[any])(nil)
+	_ ReadSlicer[any] = readOnly[any]{}
+	_ ReadSlicer[any] = (*KeyIndexed[any])(nil)
+	_ Remover[any]    = (*KeyIndexed[any])(nil)
+	_ Reslicer[any]   = (*KeyIndexed[any])(nil)
+	_ Sorter[any]     = (*KeyIndexed[any])(nil)
+	_ Ranger[any]     = (*Query[any])(nil)
 )
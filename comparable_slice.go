@@ -18,20 +18,38 @@ func NewComparableSlice[T comparable](slice ...T) *ComparableSlice[T] {
 //
 // implements the slices.Clone function on the internal slice to create the new structure.
 func (s ComparableSlice[T]) Clone() *ComparableSlice[T] {
-	return NewComparableSlice(slices.Clone(s.slice)...)
+	return &ComparableSlice[T]{s.Slice.Clone()}
+}
+
+// usesOperator reports whether the elements can be compared with the == operator,
+// that is, when no EqualityStrategy is set and T does not implement Equaler.
+func (s ComparableSlice[T]) usesOperator() bool {
+	return s.eq == nil && !hasEqualMethod[T]()
 }
 
 // A shortcut to slices.Index.
+// Falls back to Slice.Index when a custom equality is in use.
 func (s ComparableSlice[T]) Index(v T) int {
+	if !s.usesOperator() {
+		return s.Slice.Index(v)
+	}
 	return slices.Index(s.slice, v)
 }
 
 // A shortcut to slices.Contains.
+// Falls back to Slice.Contains when a custom equality is in use.
 func (s ComparableSlice[T]) Contains(v T) bool {
+	if !s.usesOperator() {
+		return s.Slice.Contains(v)
+	}
 	return slices.Contains(s.slice, v)
 }
 
 // A shortcut to slices.Equal.
+// Falls back to Slice.Equal when a custom equality is in use.
 func (s ComparableSlice[T]) Equal(v []T) bool {
+	if !s.usesOperator() {
+		return s.Slice.Equal(v)
+	}
 	return slices.Equal(s.slice, v)
 }
@@ -0,0 +1,107 @@
+package slicelib
+
+import (
+	"cmp"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Comparator compares two elements, returning a negative number when a < b,
+// a positive one when a > b and zero when they are equivalent.
+// It can be passed anywhere a func(a, b T) int is expected, such as SortFunc.
+//
+// Example:
+//
+//	byDept := By(func(e Employee) string { return e.Dept })
+//	bySalary := By(func(e Employee) int { return e.Salary })
+//	employees.SortStableFunc(byDept.Then(bySalary.Reverse()))
+type Comparator[T any] func(a, b T) int
+
+// By returns a Comparator ordering the elements by the key returned by key.
+func By[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Then returns a Comparator that breaks the ties of c with next.
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Reverse returns a Comparator with the opposite order of c.
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// NilsFirst returns a Comparator of pointers that puts nil pointers before the
+// others, and orders the rest by the values they point to with c.
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		}
+		return c(*a, *b)
+	}
+}
+
+// NilsLast is like NilsFirst but puts nil pointers after the others.
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	first := NilsFirst(c.Reverse())
+	return first.Reverse()
+}
+
+// CaseInsensitive returns a Comparator ordering the elements by the string returned
+// by key, ignoring case differences as defined by Unicode simple case folding.
+func CaseInsensitive[T any](key func(T) string) Comparator[T] {
+	return func(a, b T) int {
+		return compareFold(key(a), key(b))
+	}
+}
+
+// compareFold compares two strings rune by rune after folding their case,
+// without allocating.
+func compareFold(a, b string) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if r := cmp.Compare(unicode.ToLower(unicode.ToUpper(ra)), unicode.ToLower(unicode.ToUpper(rb))); r != 0 {
+			return r
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// SortBy sorts s by the first Comparator, breaking ties with the following ones.
+// The sort is stable, so elements equivalent for every Comparator keep their order.
+//
+// Example:
+//
+//	SortBy[Employee](employees,
+//		By(func(e Employee) string { return e.Dept }),
+//		By(func(e Employee) int { return e.Salary }).Reverse(),
+//		CaseInsensitive(func(e Employee) string { return e.Name }),
+//	)
+func SortBy[T any](s Sorter[T], by ...Comparator[T]) {
+	s.SortStableFunc(func(a, b T) int {
+		for _, c := range by {
+			if r := c(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	})
+}
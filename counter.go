@@ -0,0 +1,217 @@
+package slicelib
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ValueCount is a value with its number of occurrences.
+type ValueCount[T any] struct {
+	Value T
+	Count int
+}
+
+// Counter is a multiset: it counts the occurrences of comparable values.
+// Only positive counts are kept, so subtracting a value as many times as it
+// was added removes it.
+//
+// Counter remembers the order in which values were first added,
+// which breaks the ties of MostCommon.
+//
+// The zero value is an empty Counter ready to use.
+type Counter[T comparable] struct {
+	counts map[T]int
+	first  map[T]int // Order in which each value was first added
+	next   int
+}
+
+// NewCounter creates a Counter with the occurrences of items.
+//
+// Example:
+//
+//	c := NewCounter(strings.Fields("the cat and the hat")...)
+//	c.MostCommon(1) // [{the 2}]
+func NewCounter[T comparable](items ...T) *Counter[T] {
+	c := &Counter[T]{counts: make(map[T]int), first: make(map[T]int)}
+	c.Add(items...)
+	return c
+}
+
+// CounterOf creates a Counter with the occurrences of the elements of s,
+// which can be any sequence of comparable elements, such as a LinkedList.
+// Elements are compared with the == operator.
+func CounterOf[T comparable](s Ranger[T]) *Counter[T] {
+	c := NewCounter[T]()
+	s.Range(func(_ int, v T) bool {
+		c.AddCount(v, 1)
+		return true
+	})
+	return c
+}
+
+// Add counts one more occurrence of every item.
+func (c *Counter[T]) Add(items ...T) {
+	for _, v := range items {
+		c.AddCount(v, 1)
+	}
+}
+
+// Subtract counts one occurrence less of every item.
+func (c *Counter[T]) Subtract(items ...T) {
+	for _, v := range items {
+		c.AddCount(v, -1)
+	}
+}
+
+// AddCount adds n, which can be negative, to the count of v.
+// Values whose count drops to zero or below are removed.
+func (c *Counter[T]) AddCount(v T, n int) {
+	count := c.counts[v] + n
+	if count <= 0 {
+		delete(c.counts, v)
+		delete(c.first, v)
+		return
+	}
+
+	if c.counts == nil {
+		c.counts = make(map[T]int)
+		c.first = make(map[T]int)
+	}
+	if _, ok := c.first[v]; !ok {
+		c.first[v] = c.next
+		c.next++
+	}
+	c.counts[v] = count
+}
+
+// Merge adds the counts of other to the Counter.
+// Values new to the Counter are added in the order they were added to other.
+func (c *Counter[T]) Merge(other *Counter[T]) {
+	values := make([]T, 0, len(other.counts))
+	for v := range other.counts {
+		values = append(values, v)
+	}
+	slices.SortFunc(values, func(a, b T) int {
+		return cmp.Compare(other.first[a], other.first[b])
+	})
+
+	for _, v := range values {
+		c.AddCount(v, other.counts[v])
+	}
+}
+
+// SubtractCounter subtracts the counts of other from the Counter.
+func (c *Counter[T]) SubtractCounter(other *Counter[T]) {
+	for v, n := range other.counts {
+		c.AddCount(v, -n)
+	}
+}
+
+// Get returns the count of v, 0 if it is not in the Counter.
+func (c *Counter[T]) Get(v T) int {
+	return c.counts[v]
+}
+
+// Len returns the number of distinct values.
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
+// Total returns the sum of all the counts.
+func (c *Counter[T]) Total() (total int) {
+	for _, n := range c.counts {
+		total += n
+	}
+	return
+}
+
+// Map returns a copy of the counts.
+func (c *Counter[T]) Map() map[T]int {
+	m := make(map[T]int, len(c.counts))
+	for v, n := range c.counts {
+		m[v] = n
+	}
+	return m
+}
+
+// Clone creates a copy of the Counter.
+func (c *Counter[T]) Clone() *Counter[T] {
+	clone := NewCounter[T]()
+	clone.Merge(c)
+	return clone
+}
+
+// MostCommon returns the n most common values with their counts, from the most common one.
+// Ties are ordered by the first time each value was added.
+// A negative n returns every value.
+func (c *Counter[T]) MostCommon(n int) []ValueCount[T] {
+	all := make([]ValueCount[T], 0, len(c.counts))
+	for v, count := range c.counts {
+		all = append(all, ValueCount[T]{Value: v, Count: count})
+	}
+	slices.SortFunc(all, func(a, b ValueCount[T]) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+		return cmp.Compare(c.first[a.Value], c.first[b.Value])
+	})
+
+	if n >= 0 && n < len(all) {
+		all = all[:n]
+	}
+	return all
+}
+
+// counter returns a Counter of the elements.
+// With a custom equality, every element is counted under the first element equal to it.
+func (s ComparableSlice[T]) counter() *Counter[T] {
+	if s.usesOperator() {
+		return CounterOf[T](s.Slice)
+	}
+
+	eq := s.equal()
+	var reps []T
+	c := NewCounter[T]()
+	for _, v := range s.slice {
+		i := slices.IndexFunc(reps, func(r T) bool { return eq(r, v) })
+		if i == -1 {
+			reps = append(reps, v)
+			i = len(reps) - 1
+		}
+		c.AddCount(reps[i], 1)
+	}
+	return c
+}
+
+// Count returns the number of occurrences of v.
+// Uses the same comparison strategy as Index.
+func (s ComparableSlice[T]) Count(v T) (n int) {
+	if !s.usesOperator() {
+		eq := s.equal()
+		for _, e := range s.slice {
+			if eq(v, e) {
+				n++
+			}
+		}
+		return
+	}
+
+	for _, e := range s.slice {
+		if e == v {
+			n++
+		}
+	}
+	return
+}
+
+// Frequencies returns the number of occurrences of every distinct element.
+// With a custom equality, elements are counted under the first element equal to them.
+func (s ComparableSlice[T]) Frequencies() map[T]int {
+	return s.counter().counts
+}
+
+// MostCommon returns the n most common elements with their counts, from the most common one.
+// Ties are ordered by first occurrence. A negative n returns every distinct element.
+func (s ComparableSlice[T]) MostCommon(n int) []ValueCount[T] {
+	return s.counter().MostCommon(n)
+}
@@ -0,0 +1,304 @@
+package slicelib
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+// COWSlice is a copy-on-write variant of Slice.
+// Clone is O(1): the clones share the same backing array until one of them
+// is mutated, at which point only the writer copies the elements.
+//
+// S and SliceP give direct access to the storage, so they detach the receiver
+// from any shared array first and mark it as escaped; clones of an escaped
+// COWSlice are always full copies.
+//
+// Different COWSlice values can be used from different goroutines,
+// but a single value is not safe for concurrent use while it is being mutated.
+type COWSlice[T any] struct {
+	s       Slice[T]
+	refs    *atomic.Int32 // Number of COWSlices sharing the array, nil if not shared
+	escaped bool          // The storage was handed out through S or SliceP
+}
+
+// NewCOWSlice creates a new COWSlice instance with the provided elements.
+//
+// Example:
+//
+//	a := NewCOWSlice(1, 2, 3)
+//	b := a.Clone() // no copy yet
+//	b.Append(4)    // b copies the elements, a is left untouched
+func NewCOWSlice[T any](slice ...T) *COWSlice[T] {
+	return &COWSlice[T]{s: Slice[T]{slice: slices.Clone(slice)}}
+}
+
+// own makes sure the receiver is the only owner of its backing array,
+// copying it if it is shared with other clones.
+func (c *COWSlice[T]) own() {
+	if c.Shared() {
+		c.s.slice = slices.Clone(c.s.slice)
+	}
+	c.release()
+}
+
+// release stops sharing the backing array without copying it.
+func (c *COWSlice[T]) release() {
+	if c.refs != nil {
+		c.refs.Add(-1)
+		c.refs = nil
+	}
+}
+
+// Shared reports whether the backing array is currently shared with a clone.
+// Clones that are discarded without being mutated still count as sharers,
+// which may cause one unnecessary copy on the next write.
+func (c *COWSlice[T]) Shared() bool {
+	return c.refs != nil && c.refs.Load() > 1
+}
+
+// Clone returns a COWSlice sharing the backing array with the receiver.
+// The copy is delayed until either of them is mutated.
+// If the storage of the receiver has escaped, the elements are copied immediately.
+func (c *COWSlice[T]) Clone() *COWSlice[T] {
+	if c.escaped {
+		return &COWSlice[T]{s: *c.s.Clone()}
+	}
+
+	if c.refs == nil {
+		c.refs = new(atomic.Int32)
+		c.refs.Store(1)
+	}
+	c.refs.Add(1)
+
+	return &COWSlice[T]{s: c.s, refs: c.refs}
+}
+
+// SetEquality sets the strategy used to compare elements in Index, LastIndex,
+// Contains, Remove, RemoveLast, RemoveDuplicates and Equal.
+// A nil strategy restores the default comparison.
+func (c *COWSlice[T]) SetEquality(eq EqualityStrategy[T]) {
+	c.s.SetEquality(eq)
+}
+
+// equality returns the EqualityStrategy set on the COWSlice, if any.
+func (c *COWSlice[T]) equality() EqualityStrategy[T] {
+	return c.s.eq
+}
+
+// equal returns the comparison strategy of the slice.
+func (c *COWSlice[T]) equal() func(T, T) bool {
+	return c.s.equal()
+}
+
+// CloneS returns a clone of the underlying slice.
+func (c *COWSlice[T]) CloneS() []T {
+	return c.s.CloneS()
+}
+
+// At returns the element at the specified index.
+// Panics if the index is out of bounds.
+func (c *COWSlice[T]) At(index int) T {
+	return c.s.At(index)
+}
+
+// S returns the underlying built-in slice.
+// The receiver stops sharing its array and further clones will copy it.
+func (c *COWSlice[T]) S() []T {
+	c.own()
+	c.escaped = true
+	return c.s.S()
+}
+
+// SliceP returns a pointer to the underlying slice.
+// The receiver stops sharing its array and further clones will copy it.
+func (c *COWSlice[T]) SliceP() *[]T {
+	c.own()
+	c.escaped = true
+	return c.s.SliceP()
+}
+
+// sliceP gives read-only access to the storage without detaching it.
+func (c *COWSlice[T]) sliceP() *[]T {
+	return c.s.sliceP()
+}
+
+// Append adds one or more elements to the end of the slice.
+func (c *COWSlice[T]) Append(items ...T) {
+	c.own()
+	c.s.Append(items...)
+}
+
+// Cap returns the capacity of the underlying slice.
+func (c *COWSlice[T]) Cap() int {
+	return c.s.Cap()
+}
+
+// Set replaces the element at the specified index.
+func (c *COWSlice[T]) Set(i int, v T) {
+	c.own()
+	c.s.Set(i, v)
+}
+
+// Clear removes all elements from the slice,
+// releasing the shared array if any.
+func (c *COWSlice[T]) Clear() {
+	c.release()
+	c.s.Clear()
+}
+
+// Index finds the first occurrence of the specified value in the slice.
+// Returns -1 if not found.
+func (c *COWSlice[T]) Index(v T) int {
+	return c.s.Index(v)
+}
+
+// LastIndex finds the last occurrence of the specified value in the slice.
+// Returns -1 if not found.
+func (c *COWSlice[T]) LastIndex(v T) int {
+	return c.s.LastIndex(v)
+}
+
+// Insert adds one or more elements at the specified index.
+// Panics if the index is out of bounds.
+func (c *COWSlice[T]) Insert(index int, items ...T) {
+	c.own()
+	c.s.Insert(index, items...)
+}
+
+// Delete removes elements from the slice between indices i and j.
+func (c *COWSlice[T]) Delete(i, j int) {
+	c.own()
+	c.s.Delete(i, j)
+}
+
+// Pop removes the element at the specified index.
+func (c *COWSlice[T]) Pop(index int) {
+	c.own()
+	c.s.Pop(index)
+}
+
+// Remove finds and removes the first occurrence of the specified value.
+func (c *COWSlice[T]) Remove(v T) {
+	c.Pop(c.Index(v))
+}
+
+// RemoveLast finds and removes the last occurrence of the specified value.
+func (c *COWSlice[T]) RemoveLast(v T) {
+	c.Pop(c.LastIndex(v))
+}
+
+// Reverse changes the order of elements in the slice to their reverse.
+func (c *COWSlice[T]) Reverse() {
+	c.own()
+	c.s.Reverse()
+}
+
+// IsEmpty checks if the slice contains no elements.
+func (c *COWSlice[T]) IsEmpty() bool {
+	return c.s.IsEmpty()
+}
+
+// Len returns the number of elements in the slice.
+func (c *COWSlice[T]) Len() int {
+	return c.s.Len()
+}
+
+// InRange checks if the given index is within the slice's bounds.
+func (c *COWSlice[T]) InRange(i int) bool {
+	return c.s.InRange(i)
+}
+
+// Contains checks if the slice includes the specified value.
+func (c *COWSlice[T]) Contains(v T) bool {
+	return c.s.Contains(v)
+}
+
+// RemoveDuplicates eliminates duplicate elements, keeping only unique values.
+func (c *COWSlice[T]) RemoveDuplicates() {
+	c.own()
+	c.s.RemoveDuplicates()
+}
+
+// Equal compares the slice with another slice for equality.
+func (c *COWSlice[T]) Equal(v []T) bool {
+	return c.s.Equal(v)
+}
+
+// EqualFunc allows custom equality comparison using a provided function.
+func (c *COWSlice[T]) EqualFunc(v []T, f func(e1, e2 T) bool) bool {
+	return c.s.EqualFunc(v, f)
+}
+
+// EqualSlicer compares the current slice with another Slicer.
+func (c *COWSlice[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return c.EqualSlicerFunc(v, c.equal())
+}
+
+// EqualSlicerFunc compares the current slice with another Slicer using a custom comparison function.
+func (c *COWSlice[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(c, v, f)
+}
+
+// SortFunc allows custom sorting using a comparison function.
+func (c *COWSlice[T]) SortFunc(f func(a, b T) int) {
+	c.own()
+	c.s.SortFunc(f)
+}
+
+// SortStableFunc sorts the slice with a comparison function,
+// keeping the original order of equal elements.
+func (c *COWSlice[T]) SortStableFunc(f func(a, b T) int) {
+	c.own()
+	c.s.SortStableFunc(f)
+}
+
+// Filter removes elements that do not match the provided predicate function.
+func (c *COWSlice[T]) Filter(f func(T) (pass bool)) {
+	c.own()
+	c.s.Filter(f)
+}
+
+// Range provides an iterator-like functionality for the slice.
+func (c *COWSlice[T]) Range(yield func(k int, v T) bool) {
+	c.s.Range(yield)
+}
+
+// ReverseRange iterates over the slice from the last element to the first.
+func (c *COWSlice[T]) ReverseRange(f func(int, T) bool) {
+	c.s.ReverseRange(f)
+}
+
+// String returns a string representation of the slice.
+func (c *COWSlice[T]) String() string {
+	return c.s.String()
+}
+
+// Grow increases the slice's capacity to accommodate more elements.
+func (c *COWSlice[T]) Grow(n int) {
+	c.own()
+	c.s.Grow(n)
+}
+
+// Clip reduces the slice's capacity to its length.
+func (c *COWSlice[T]) Clip() {
+	c.own()
+	c.s.Clip()
+}
+
+// SliceRight is equal to slice[:i].
+// Reslicing does not write to the array, so it does not trigger a copy.
+func (c *COWSlice[T]) SliceRight(i int) {
+	c.s.SliceRight(i)
+}
+
+// SliceLeft is equal to slice[i:].
+// Reslicing does not write to the array, so it does not trigger a copy.
+func (c *COWSlice[T]) SliceLeft(i int) {
+	c.s.SliceLeft(i)
+}
+
+// SliceRange is equal to slice[i:j].
+// Reslicing does not write to the array, so it does not trigger a copy.
+func (c *COWSlice[T]) SliceRange(i, j int) {
+	c.s.SliceRange(i, j)
+}
@@ -0,0 +1,8 @@
+//go:build slicelib_debug
+// +build slicelib_debug
+
+package slicelib
+
+// debug enables the extra input validation of the package.
+// Build with the slicelib_debug tag to turn it on.
+const debug = true
@@ -0,0 +1,349 @@
+package slicelib
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditOp is the kind of an Edit.
+type EditOp int
+
+const (
+	// EditEqual keeps elements present in both sequences.
+	EditEqual EditOp = iota
+	// EditInsert adds elements of the second sequence.
+	EditInsert
+	// EditDelete removes elements of the first sequence.
+	EditDelete
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "Equal"
+	case EditInsert:
+		return "Insert"
+	case EditDelete:
+		return "Delete"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is a run of consecutive elements with the same operation in an edit script.
+//
+// A is the position of the run in the first sequence and B its position in
+// the second one. For an insertion A is the position in the first sequence
+// where the elements are added, and for a deletion B is the position in the
+// second sequence where they would have been.
+//
+// Values holds the elements of the run, taken from the first sequence for
+// EditEqual and EditDelete and from the second one for EditInsert.
+type Edit[T any] struct {
+	Op     EditOp
+	A, B   int
+	Values []T
+}
+
+// Diff returns the shortest edit script turning a into b,
+// computed with Myers' algorithm in O((n+m)·d) time for d differences and O(n+m) space.
+//
+// Example:
+//
+//	Diff[string](NewSlice("a", "b", "c"), NewLinkedList("a", "c", "d"))
+//	// [{Equal 0 0 [a]} {Delete 1 1 [b]} {Equal 2 1 [c]} {Insert 3 2 [d]}]
+func Diff[T comparable](a, b ReadSlicer[T]) []Edit[T] {
+	return DiffFunc(a, b, comparableEqual2[T])
+}
+
+// DiffFunc is like Diff but compares the elements with eq.
+func DiffFunc[T any](a, b ReadSlicer[T], eq func(a, b T) bool) []Edit[T] {
+	return diffSlices(collect(a), collect(b), eq)
+}
+
+// collect returns the elements of s in a new slice.
+func collect[T any](s ReadSlicer[T]) []T {
+	items := make([]T, 0, s.Len())
+	s.Range(func(_ int, v T) bool {
+		items = append(items, v)
+		return true
+	})
+	return items
+}
+
+// diffSlices returns the edit script turning x into y.
+func diffSlices[T any](x, y []T, eq func(a, b T) bool) []Edit[T] {
+	return runs(myers(x, y, eq), x, y)
+}
+
+// myers returns the operations, one per element, of the shortest edit script turning x into y.
+// It uses the linear space refinement of Myers' algorithm: the middle snake of an
+// optimal path is found by searching from both ends, and the parts before and after
+// it are solved recursively, so memory stays O(n+m) however different x and y are.
+func myers[T any](x, y []T, eq func(a, b T) bool) []EditOp {
+	// vf and vb are shared by every step of the recursion, as each one is done
+	// with them before recursing. They have room for the diagonals of both
+	// searches, whose centers are up to n+m apart.
+	size := 4*(len(x)+len(y)) + 5
+	vf, vb := make([]int, size), make([]int, size)
+	ops := myersSplit(x, y, eq, vf, vb, make([]EditOp, 0, len(x)+len(y)))
+
+	// Put the deletions of every change before its insertions.
+	for i := 0; i < len(ops); {
+		if ops[i] == EditEqual {
+			i++
+			continue
+		}
+		j, deletes := i, 0
+		for ; j < len(ops) && ops[j] != EditEqual; j++ {
+			if ops[j] == EditDelete {
+				deletes++
+			}
+		}
+		for k := i; k < j; k++ {
+			ops[k] = EditInsert
+			if k < i+deletes {
+				ops[k] = EditDelete
+			}
+		}
+		i = j
+	}
+	return ops
+}
+
+// myersSplit appends the operations turning x into y to ops.
+func myersSplit[T any](x, y []T, eq func(a, b T) bool, vf, vb []int, ops []EditOp) []EditOp {
+	// Common prefixes and suffixes are trimmed before searching.
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && eq(x[prefix], y[prefix]) {
+		prefix++
+	}
+	for range prefix {
+		ops = append(ops, EditEqual)
+	}
+	x, y = x[prefix:], y[prefix:]
+
+	suffix := 0
+	for suffix < len(x) && suffix < len(y) && eq(x[len(x)-1-suffix], y[len(y)-1-suffix]) {
+		suffix++
+	}
+	x, y = x[:len(x)-suffix], y[:len(y)-suffix]
+
+	switch {
+	case len(x) == 0:
+		for range y {
+			ops = append(ops, EditInsert)
+		}
+	case len(y) == 0:
+		for range x {
+			ops = append(ops, EditDelete)
+		}
+	default:
+		// Both are non-empty and start and end differently,
+		// so the middle snake splits them into two smaller problems.
+		xs, ys, xe, ye := middleSnake(x, y, eq, vf, vb)
+		ops = myersSplit(x[:xs], y[:ys], eq, vf, vb, ops)
+		for range xe - xs {
+			ops = append(ops, EditEqual)
+		}
+		ops = myersSplit(x[xe:], y[ye:], eq, vf, vb, ops)
+	}
+
+	for range suffix {
+		ops = append(ops, EditEqual)
+	}
+	return ops
+}
+
+// middleSnake returns the start (xs, ys) and end (xe, ye) of the snake
+// in the middle of a shortest edit path from (0, 0) to (len(x), len(y)).
+func middleSnake[T any](x, y []T, eq func(a, b T) bool, vf, vb []int) (xs, ys, xe, ye int) {
+	n, m := len(x), len(y)
+	delta := n - m
+	odd := delta%2 != 0
+	// Diagonal k = x - y is stored at offset+k. The forward search covers the
+	// diagonals [-d, d] and the reverse one, from (n, m), [delta-d, delta+d].
+	offset := (len(vf) - 1) / 2
+
+	// vf holds the furthest x reached forward on each diagonal,
+	// and vb the smallest x reached backwards.
+	vf[offset+1] = 0
+	vb[offset+delta+1] = n + 1
+
+	for d := 0; d <= (n+m+1)/2; d++ {
+		for k := -d; k <= d; k += 2 {
+			var i int
+			if k == -d || k != d && vf[offset+k-1] < vf[offset+k+1] {
+				i = vf[offset+k+1]
+			} else {
+				i = vf[offset+k-1] + 1
+			}
+			j := i - k
+			si, sj := i, j
+			for i < n && j < m && eq(x[i], y[j]) {
+				i++
+				j++
+			}
+			vf[offset+k] = i
+
+			if odd && delta-(d-1) <= k && k <= delta+(d-1) && i >= vb[offset+k] {
+				return si, sj, i, j
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			kk := delta + k
+			var i int
+			if k == -d || k != d && vb[offset+kk+1]-1 < vb[offset+kk-1] {
+				i = vb[offset+kk+1] - 1
+			} else {
+				i = vb[offset+kk-1]
+			}
+			j := i - kk
+			ei, ej := i, j
+			for i > 0 && j > 0 && eq(x[i-1], y[j-1]) {
+				i--
+				j--
+			}
+			vb[offset+kk] = i
+
+			if !odd && -d <= kk && kk <= d && i <= vf[offset+kk] {
+				return i, j, ei, ej
+			}
+		}
+	}
+	panic("slicelib: no middle snake")
+}
+
+// runs groups consecutive operations of the same kind into Edits.
+func runs[T any](ops []EditOp, x, y []T) []Edit[T] {
+	var (
+		edits []Edit[T]
+		i, j  int
+	)
+	for _, op := range ops {
+		if len(edits) == 0 || edits[len(edits)-1].Op != op {
+			edits = append(edits, Edit[T]{Op: op, A: i, B: j})
+		}
+		last := &edits[len(edits)-1]
+
+		switch op {
+		case EditEqual:
+			last.Values = append(last.Values, x[i])
+			i++
+			j++
+		case EditInsert:
+			last.Values = append(last.Values, y[j])
+			j++
+		case EditDelete:
+			last.Values = append(last.Values, x[i])
+			i++
+		}
+	}
+	return edits
+}
+
+// diffLine is a single element of an edit script, as rendered by Unified.
+type diffLine[T any] struct {
+	op   EditOp
+	a, b int
+	v    T
+}
+
+// Unified renders an edit script in the unified diff format,
+// with context unchanged elements around each change and one element per line,
+// formatted with fmt.Sprint. Positions in the hunk headers are 1-based.
+// Returns an empty string if there are no changes.
+//
+// Example:
+//
+//	fmt.Print(Unified(Diff[string](a, b), 3))
+//	// @@ -1,3 +1,3 @@
+//	//  a
+//	// -b
+//	//  c
+//	// +d
+func Unified[T any](edits []Edit[T], context int) string {
+	var lines []diffLine[T]
+	for _, e := range edits {
+		for k, v := range e.Values {
+			l := diffLine[T]{op: e.Op, a: e.A, b: e.B, v: v}
+			switch e.Op {
+			case EditEqual:
+				l.a += k
+				l.b += k
+			case EditInsert:
+				l.b += k
+			case EditDelete:
+				l.a += k
+			}
+			lines = append(lines, l)
+		}
+	}
+
+	var sb strings.Builder
+	for start := 0; start < len(lines); {
+		// Find the next change and extend the hunk while the gap
+		// between changes fits in the context of both.
+		first := start
+		for first < len(lines) && lines[first].op == EditEqual {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for k := first; k < len(lines) && k-last <= 2*context+1; k++ {
+			if lines[k].op != EditEqual {
+				last = k
+			}
+		}
+
+		from := max(first-context, start)
+		to := min(last+context+1, len(lines))
+		writeHunk(&sb, lines[from:to])
+		start = to
+	}
+	return sb.String()
+}
+
+// hunkRange formats a range of a hunk header, omitting the length when it is 1 as in diff -u.
+func hunkRange(start, n int) string {
+	if n == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, n)
+}
+
+// writeHunk writes a hunk header followed by its lines.
+func writeHunk[T any](sb *strings.Builder, hunk []diffLine[T]) {
+	var na, nb int
+	for _, l := range hunk {
+		if l.op != EditInsert {
+			na++
+		}
+		if l.op != EditDelete {
+			nb++
+		}
+	}
+
+	// Empty ranges start at the line before them, as in diff -u.
+	a, b := hunk[0].a+1, hunk[0].b+1
+	if na == 0 {
+		a--
+	}
+	if nb == 0 {
+		b--
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(a, na), hunkRange(b, nb))
+
+	for _, l := range hunk {
+		prefix := " "
+		switch l.op {
+		case EditInsert:
+			prefix = "+"
+		case EditDelete:
+			prefix = "-"
+		}
+		fmt.Fprintf(sb, "%s%v\n", prefix, l.v)
+	}
+}
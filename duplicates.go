@@ -0,0 +1,72 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// Keep selects which occurrence of a duplicated element is preserved.
+type Keep int
+
+const (
+	// KeepFirst preserves the first occurrence of each element.
+	KeepFirst Keep = iota
+	// KeepLast preserves the last occurrence of each element.
+	KeepLast
+)
+
+// removeDuplicates keeps the elements of s accepted by the stateful predicate unique,
+// visiting them in the order selected by keep.
+func removeDuplicates[T any](s Slicer[T], keep Keep, unique func(T) bool) {
+	kept := make([]T, 0, s.Len())
+	collect := func(_ int, t T) bool {
+		if unique(t) {
+			kept = append(kept, t)
+		}
+		return true
+	}
+
+	if keep == KeepLast {
+		s.ReverseRange(collect)
+		slices.Reverse(kept)
+	} else {
+		s.Range(collect)
+	}
+
+	if len(kept) == s.Len() {
+		return
+	}
+	s.Clear()
+	s.Append(kept...)
+}
+
+// RemoveDuplicatesFunc removes the elements of s whose key was already seen,
+// preserving the first or the last occurrence of each key according to keep.
+// The remaining elements stay in their original order.
+// Works for any element type, as only the keys need to be comparable.
+//
+// Example:
+//
+//	users := NewSlice(User{ID: 1, Name: "a"}, User{ID: 1, Name: "b"})
+//	RemoveDuplicatesFunc(users, func(u User) int { return u.ID }, KeepLast)
+//	// [{1 b}]
+func RemoveDuplicatesFunc[T any, K comparable](s Slicer[T], key func(T) K, keep Keep) {
+	seen := make(map[K]bool)
+	removeDuplicates(s, keep, func(t T) bool {
+		k := key(t)
+		if seen[k] {
+			return false
+		}
+		seen[k] = true
+		return true
+	})
+}
+
+// RemoveDuplicatesEqual removes the elements of s that are equal to another one according to eq,
+// preserving the first or the last occurrence according to keep.
+// The remaining elements stay in their original order.
+//
+// Each element is compared with the ones already kept, so it runs in O(n²);
+// prefer RemoveDuplicatesFunc when a comparable key can be derived from the elements.
+func RemoveDuplicatesEqual[T any](s Slicer[T], eq func(a, b T) bool, keep Keep) {
+	removeDuplicates(s, keep, uniqueFunc(eq))
+}
@@ -0,0 +1,124 @@
+package slicelib
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// MinFunc returns the minimal element of s according to cmp,
+// the first one if there are several.
+// Works over any sequence in a single pass, without sorting it.
+//
+// Panics if s is empty.
+func MinFunc[T any](s Ranger[T], cmp func(a, b T) int) T {
+	i, v := argFunc(s, func(a, b T) bool { return cmp(a, b) < 0 })
+	if i == -1 {
+		panic("slicelib: MinFunc of an empty sequence")
+	}
+	return v
+}
+
+// MaxFunc returns the maximal element of s according to cmp,
+// the first one if there are several.
+// Works over any sequence in a single pass, without sorting it.
+//
+// Panics if s is empty.
+func MaxFunc[T any](s Ranger[T], cmp func(a, b T) int) T {
+	i, v := argFunc(s, func(a, b T) bool { return cmp(a, b) > 0 })
+	if i == -1 {
+		panic("slicelib: MaxFunc of an empty sequence")
+	}
+	return v
+}
+
+// ArgMinFunc returns the index of the minimal element of s according to cmp,
+// the first one if there are several, or -1 if s is empty.
+//
+// Example:
+//
+//	cheapest := ArgMinFunc(products, func(a, b Product) int {
+//		return cmp.Compare(a.Price, b.Price)
+//	})
+func ArgMinFunc[T any](s Ranger[T], cmp func(a, b T) int) int {
+	i, _ := argFunc(s, func(a, b T) bool { return cmp(a, b) < 0 })
+	return i
+}
+
+// ArgMaxFunc returns the index of the maximal element of s according to cmp,
+// the first one if there are several, or -1 if s is empty.
+func ArgMaxFunc[T any](s Ranger[T], cmp func(a, b T) int) int {
+	i, _ := argFunc(s, func(a, b T) bool { return cmp(a, b) > 0 })
+	return i
+}
+
+// argFunc returns the first element of s that no other one is better than, and its index.
+// The index is -1 if s is empty.
+func argFunc[T any](s Ranger[T], better func(a, b T) bool) (index int, best T) {
+	index = -1
+	s.Range(func(i int, v T) bool {
+		if index == -1 || better(v, best) {
+			index, best = i, v
+		}
+		return true
+	})
+	return
+}
+
+// TopK returns the k greatest elements of s according to cmp, in descending order.
+// If s has fewer than k elements, all of them are returned.
+//
+// Keeps a heap of k elements, so it runs in O(n log k) time and O(k) space.
+func TopK[T any](s Ranger[T], k int, cmp func(a, b T) int) []T {
+	top := boundedK(s, k, cmp)
+	slices.SortFunc(top, func(a, b T) int { return cmp(b, a) })
+	return top
+}
+
+// BottomK returns the k smallest elements of s according to cmp, in ascending order.
+// If s has fewer than k elements, all of them are returned.
+//
+// Keeps a heap of k elements, so it runs in O(n log k) time and O(k) space.
+func BottomK[T any](s Ranger[T], k int, cmp func(a, b T) int) []T {
+	bottom := boundedK(s, k, func(a, b T) int { return cmp(b, a) })
+	slices.SortFunc(bottom, cmp)
+	return bottom
+}
+
+// boundedK returns the k greatest elements of s according to cmp, in no particular order.
+func boundedK[T any](s Ranger[T], k int, cmp func(a, b T) int) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	// A min-heap whose root is the smallest of the greatest elements seen so far.
+	h := &kHeap[T]{cmp: cmp}
+	s.Range(func(_ int, v T) bool {
+		switch {
+		case len(h.items) < k:
+			heap.Push(h, v)
+		case cmp(v, h.items[0]) > 0:
+			h.items[0] = v
+			heap.Fix(h, 0)
+		}
+		return true
+	})
+	return h.items
+}
+
+// kHeap is a min-heap ordered by cmp, used by TopK and BottomK.
+type kHeap[T any] struct {
+	items []T
+	cmp   func(a, b T) int
+}
+
+func (h *kHeap[T]) Len() int           { return len(h.items) }
+func (h *kHeap[T]) Less(i, j int) bool { return h.cmp(h.items[i], h.items[j]) < 0 }
+func (h *kHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *kHeap[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+
+func (h *kHeap[T]) Pop() any {
+	n := len(h.items) - 1
+	x := h.items[n]
+	h.items = h.items[:n]
+	return x
+}
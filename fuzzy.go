@@ -0,0 +1,280 @@
+package slicelib
+
+import (
+	"cmp"
+	"slices"
+	"unicode"
+)
+
+// Match is an element found by FuzzyFind.
+type Match[T any] struct {
+	Index     int   // Position of the element in the sequence
+	Value     T     // The element
+	Score     int   // Higher is better
+	Positions []int // Rune positions of the matched runes of the text, in ascending order
+}
+
+// Scores of the fuzzy matcher, modelled on the ones of fzf.
+const (
+	scoreMatch        = 16
+	scoreGapStart     = -3
+	scoreGapExtension = -1
+
+	// Matches at the start of a word are favoured.
+	bonusBoundary          = scoreMatch / 2
+	bonusBoundaryWhite     = bonusBoundary + 2
+	bonusBoundaryDelimiter = bonusBoundary + 1
+	bonusNonWord           = scoreMatch / 2
+	// Matches at camelCase or letter to number transitions.
+	bonusCamel123 = bonusBoundary - 1
+	// Consecutive matches get at least this bonus, which cancels the cost of a gap.
+	bonusConsecutive = -(scoreGapStart + scoreGapExtension)
+	// The bonus of the first rune of the query counts more.
+	bonusFirstCharMultiplier = 2
+)
+
+type charClass int
+
+const (
+	charWhite charClass = iota
+	charNonWord
+	charDelimiter
+	charLower
+	charUpper
+	charLetter
+	charNumber
+)
+
+func classOf(r rune) charClass {
+	switch {
+	case unicode.IsSpace(r):
+		return charWhite
+	case r == '/' || r == ',' || r == ':' || r == ';' || r == '|' || r == '_' || r == '-' || r == '.':
+		return charDelimiter
+	case unicode.IsLower(r):
+		return charLower
+	case unicode.IsUpper(r):
+		return charUpper
+	case unicode.IsLetter(r):
+		return charLetter
+	case unicode.IsNumber(r):
+		return charNumber
+	}
+	return charNonWord
+}
+
+// bonusFor returns the bonus of matching a rune of class cur that follows one of class prev.
+func bonusFor(prev, cur charClass) int {
+	if cur > charDelimiter {
+		switch prev {
+		case charWhite:
+			return bonusBoundaryWhite
+		case charDelimiter:
+			return bonusBoundaryDelimiter
+		case charNonWord:
+			return bonusBoundary
+		}
+	}
+	if prev == charLower && cur == charUpper || prev != charNumber && cur == charNumber {
+		return bonusCamel123
+	}
+	switch cur {
+	case charNonWord, charDelimiter:
+		return bonusNonWord
+	case charWhite:
+		return bonusBoundaryWhite
+	}
+	return 0
+}
+
+// fuzzyMatch scores the best alignment of query as a subsequence of text,
+// returning the rune positions of text matched by each rune of query.
+// The query must be non-empty; ok is false if it is not a subsequence of text.
+func fuzzyMatch(text, query []rune, fold bool) (score int, positions []int, ok bool) {
+	eq := func(t, q rune) bool {
+		if fold {
+			t = unicode.ToLower(t)
+		}
+		return t == q
+	}
+
+	// Skip the texts that cannot match before doing any real work.
+	i := 0
+	for _, r := range text {
+		if i < len(query) && eq(r, query[i]) {
+			i++
+		}
+	}
+	if i < len(query) {
+		return
+	}
+
+	n, m := len(text), len(query)
+	bonus := make([]int, n)
+	prev := charWhite
+	for j, r := range text {
+		cur := classOf(r)
+		bonus[j] = bonusFor(prev, cur)
+		prev = cur
+	}
+
+	// scores[i][j] is the best score of the first i+1 runes of the query with
+	// query[i] matched at text[j], from[i][j] the position of query[i-1], and
+	// run[i][j] the bonus of the first rune of the run of consecutive matches
+	// ending at j, which is extended to the whole run as fzf does.
+	const none = -1 << 31
+	scores := make([][]int, m)
+	from := make([][]int, m)
+	run := make([][]int, m)
+	for i := range m {
+		scores[i] = make([]int, n)
+		from[i] = make([]int, n)
+		run[i] = make([]int, n)
+		for j := range n {
+			scores[i][j] = none
+		}
+	}
+
+	for j := range n {
+		if eq(text[j], query[0]) {
+			scores[0][j] = scoreMatch + bonus[j]*bonusFirstCharMultiplier
+			run[0][j] = bonus[j]
+		}
+	}
+	for i := 1; i < m; i++ {
+		// Best score of query[i-1] matched before j-1, with the cost of the gap up to j.
+		gap, gapFrom := none, -1
+		for j := i; j < n; j++ {
+			if gap != none {
+				gap += scoreGapExtension
+			}
+			if j >= 2 {
+				if p := scores[i-1][j-2]; p != none && p+scoreGapStart > gap {
+					gap, gapFrom = p+scoreGapStart, j-2
+				}
+			}
+			if !eq(text[j], query[i]) {
+				continue
+			}
+
+			best, bestFrom, bestRun := none, -1, bonus[j]
+			if gap != none {
+				best, bestFrom = gap+scoreMatch+bonus[j], gapFrom
+			}
+			if p := scores[i-1][j-1]; p != none {
+				first := run[i-1][j-1]
+				b := max(bonus[j], first, bonusConsecutive)
+				if s := p + scoreMatch + b; s >= best {
+					best, bestFrom, bestRun = s, j-1, first
+					// A new word within the run takes over its bonus.
+					if bonus[j] >= bonusBoundary && bonus[j] > first {
+						bestRun = bonus[j]
+					}
+				}
+			}
+			scores[i][j], from[i][j], run[i][j] = best, bestFrom, bestRun
+		}
+	}
+
+	end := -1
+	score = none
+	for j := range n {
+		if scores[m-1][j] > score {
+			score, end = scores[m-1][j], j
+		}
+	}
+	if end == -1 {
+		return 0, nil, false
+	}
+
+	positions = make([]int, m)
+	for i := m - 1; i >= 0; i-- {
+		positions[i] = end
+		end = from[i][end]
+	}
+	return score, positions, true
+}
+
+// FuzzyFind returns the strings of s that contain the runes of query in order,
+// not necessarily contiguous, ranked from the best match like fzf does:
+// consecutive runes and runes at the start of words, after delimiters or at
+// camelCase transitions score higher, and gaps are penalized.
+// Ties are ranked by the length of the string, then by position.
+//
+// The search ignores case unless query contains an uppercase letter.
+// An empty query matches every string with a zero score.
+//
+// Example:
+//
+//	files := NewSlice("src/main.go", "README.md", "slice_main_test.go")
+//	FuzzyFind(files, "mgo") // [{0 src/main.go ...} {2 slice_main_test.go ...}]
+func FuzzyFind(s Ranger[string], query string) []Match[string] {
+	return FuzzyFindFunc(s, query, func(v string) string { return v })
+}
+
+// FuzzyFindFunc is like FuzzyFind but searches the string returned by key for every element.
+//
+// Example:
+//
+//	FuzzyFindFunc[Product](products, "cofmug", func(p Product) string { return p.Name })
+func FuzzyFindFunc[T any](s Ranger[T], query string, key func(T) string) []Match[T] {
+	q := []rune(query)
+	fold := true
+	for i, r := range q {
+		if unicode.IsUpper(r) {
+			fold = false
+			break
+		}
+		q[i] = unicode.ToLower(r)
+	}
+	if !fold {
+		q = []rune(query)
+	}
+
+	var (
+		matches []Match[T]
+		lengths []int
+	)
+	s.Range(func(i int, v T) bool {
+		if len(q) == 0 {
+			matches = append(matches, Match[T]{Index: i, Value: v})
+			return true
+		}
+
+		runes := []rune(key(v))
+		if score, positions, ok := fuzzyMatch(runes, q, fold); ok {
+			matches = append(matches, Match[T]{Index: i, Value: v, Score: score, Positions: positions})
+			lengths = append(lengths, len(runes))
+		}
+		return true
+	})
+	if len(q) == 0 {
+		return matches
+	}
+
+	order := make([]int, len(matches))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		if r := cmp.Compare(matches[b].Score, matches[a].Score); r != 0 {
+			return r
+		}
+		if r := cmp.Compare(lengths[a], lengths[b]); r != 0 {
+			return r
+		}
+		return cmp.Compare(matches[a].Index, matches[b].Index)
+	})
+
+	ranked := make([]Match[T], len(matches))
+	for i, o := range order {
+		ranked[i] = matches[o]
+	}
+	return ranked
+}
+
+// FuzzyFind returns the strings that fuzzily match query, ranked from the best match.
+// See the FuzzyFind function.
+func (s StringSlice) FuzzyFind(query string) []Match[string] {
+	return FuzzyFind(s.Slice, query)
+}
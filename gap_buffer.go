@@ -0,0 +1,384 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// minGapSize is the smallest gap allocated when a GapBuffer grows.
+const minGapSize = 16
+
+// GapBuffer is a generic sequence backed by a single array with a movable gap.
+// Insertions and deletions next to the gap (the cursor) are O(1) amortized,
+// which makes it a good fit for editor-style workloads where
+// edits are clustered around a position.
+//
+// The elements live in buf[:gapStart] and buf[gapEnd:].
+type GapBuffer[T any] struct {
+	buf      []T
+	gapStart int
+	gapEnd   int
+
+	eq EqualityStrategy[T] // Custom equality, nil for the default one
+}
+
+// NewGapBuffer creates a new GapBuffer with the provided elements,
+// placing the gap (and the cursor) at the end.
+//
+// Example:
+//
+//	gb := NewGapBuffer([]rune("hello")...)
+func NewGapBuffer[T any](slice ...T) *GapBuffer[T] {
+	gb := new(GapBuffer[T])
+	gb.Append(slice...)
+
+	return gb
+}
+
+// phys translates a logical index into a position of the underlying array.
+func (gb *GapBuffer[T]) phys(i int) int {
+	if i < gb.gapStart {
+		return i
+	}
+	return i + gb.gapEnd - gb.gapStart
+}
+
+// grow makes sure that the gap can hold at least n more elements.
+func (gb *GapBuffer[T]) grow(n int) {
+	if gb.gapEnd-gb.gapStart >= n {
+		return
+	}
+
+	size := max(2*len(gb.buf), gb.Len()+n+minGapSize)
+	buf := make([]T, size)
+	copy(buf, gb.buf[:gb.gapStart])
+
+	tail := len(gb.buf) - gb.gapEnd
+	copy(buf[size-tail:], gb.buf[gb.gapEnd:])
+
+	gb.buf = buf
+	gb.gapEnd = size - tail
+}
+
+// SetEquality sets the strategy used to compare elements in Index, LastIndex,
+// Contains, Remove, RemoveLast, RemoveDuplicates and Equal.
+// A nil strategy restores the default comparison.
+func (gb *GapBuffer[T]) SetEquality(eq EqualityStrategy[T]) {
+	gb.eq = eq
+}
+
+// equality returns the EqualityStrategy set on the GapBuffer, if any.
+func (gb *GapBuffer[T]) equality() EqualityStrategy[T] {
+	return gb.eq
+}
+
+// equal returns the comparison strategy of the buffer.
+func (gb *GapBuffer[T]) equal() func(T, T) bool {
+	return strategyOr(gb.eq)
+}
+
+// Cursor returns the current position of the gap,
+// which is where the cheapest insertions happen.
+func (gb *GapBuffer[T]) Cursor() int {
+	return gb.gapStart
+}
+
+// MoveGap moves the gap (the cursor) right before the element at index i.
+// Only the elements between the old and the new position are moved.
+//
+// Panics if i is lower than 0 or greater than Len().
+func (gb *GapBuffer[T]) MoveGap(i int) {
+	if i < 0 || i > gb.Len() {
+		outOfRangePanic(i, gb.Len())
+	}
+
+	switch {
+	case i < gb.gapStart:
+		n := gb.gapStart - i
+		copy(gb.buf[gb.gapEnd-n:gb.gapEnd], gb.buf[i:gb.gapStart])
+		// Zero the stale elements left inside the new gap.
+		clear(gb.buf[i:min(gb.gapStart, gb.gapEnd-n)])
+		gb.gapStart -= n
+		gb.gapEnd -= n
+	case i > gb.gapStart:
+		n := i - gb.gapStart
+		copy(gb.buf[gb.gapStart:gb.gapStart+n], gb.buf[gb.gapEnd:gb.gapEnd+n])
+		clear(gb.buf[max(gb.gapEnd, gb.gapStart+n) : gb.gapEnd+n])
+		gb.gapStart += n
+		gb.gapEnd += n
+	}
+}
+
+// Grow increases the size of the gap to accommodate at least n more elements.
+func (gb *GapBuffer[T]) Grow(n int) {
+	gb.grow(n)
+}
+
+// Cap returns the total number of elements the buffer can hold without reallocating.
+func (gb *GapBuffer[T]) Cap() int {
+	return len(gb.buf)
+}
+
+// At returns the element at the specified index.
+// Panics if the index is out of bounds.
+func (gb *GapBuffer[T]) At(i int) T {
+	if !gb.InRange(i) {
+		outOfRangePanic(i, gb.Len())
+	}
+	return gb.buf[gb.phys(i)]
+}
+
+// Set replaces the element at the specified index.
+// Panics if the index is out of bounds.
+func (gb *GapBuffer[T]) Set(i int, v T) {
+	if !gb.InRange(i) {
+		outOfRangePanic(i, gb.Len())
+	}
+	gb.buf[gb.phys(i)] = v
+}
+
+// S returns a copy of the elements as a built-in slice.
+func (gb *GapBuffer[T]) S() []T {
+	return slices.Concat(gb.buf[:gb.gapStart], gb.buf[gb.gapEnd:])
+}
+
+// Extract returns a copy of the elements between indices i and j
+// without moving the gap.
+//
+// Panics if the range is out of bounds.
+func (gb *GapBuffer[T]) Extract(i, j int) []T {
+	if i < 0 || j > gb.Len() || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+
+	s := make([]T, 0, j-i)
+	if i < gb.gapStart {
+		s = append(s, gb.buf[i:min(j, gb.gapStart)]...)
+	}
+	if j > gb.gapStart {
+		s = append(s, gb.buf[gb.phys(max(i, gb.gapStart)):gb.phys(j-1)+1]...)
+	}
+
+	return s
+}
+
+// Len returns the number of elements in the buffer.
+func (gb *GapBuffer[T]) Len() int {
+	return len(gb.buf) - (gb.gapEnd - gb.gapStart)
+}
+
+// InRange checks if the given index is within the buffer's bounds.
+func (gb *GapBuffer[T]) InRange(i int) bool {
+	return i >= 0 && i < gb.Len()
+}
+
+// IsEmpty checks if the buffer contains no elements.
+func (gb *GapBuffer[T]) IsEmpty() bool {
+	return gb.Len() == 0
+}
+
+// Insert adds one or more elements at the specified index,
+// moving the gap there first. The cursor ends right after the inserted elements.
+//
+// Panics if the index is out of bounds.
+func (gb *GapBuffer[T]) Insert(i int, items ...T) {
+	gb.MoveGap(i)
+	gb.grow(len(items))
+	gb.gapStart += copy(gb.buf[gb.gapStart:], items)
+}
+
+// Append adds one or more elements to the end of the buffer.
+func (gb *GapBuffer[T]) Append(items ...T) {
+	gb.Insert(gb.Len(), items...)
+}
+
+// Delete removes elements between indices i and j,
+// leaving the gap at index i.
+//
+// Panics if the range is out of bounds.
+func (gb *GapBuffer[T]) Delete(i, j int) {
+	if i < 0 || j > gb.Len() || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+
+	gb.MoveGap(i)
+	clear(gb.buf[gb.gapEnd : gb.gapEnd+j-i])
+	gb.gapEnd += j - i
+}
+
+// Pop removes the element at the specified index.
+// Panics if the index is out of bounds.
+func (gb *GapBuffer[T]) Pop(i int) {
+	if !gb.InRange(i) {
+		outOfRangePanic(i, gb.Len())
+	}
+	gb.Delete(i, i+1)
+}
+
+// Clear removes all elements from the buffer, keeping its storage.
+func (gb *GapBuffer[T]) Clear() {
+	clear(gb.buf)
+	gb.gapStart = 0
+	gb.gapEnd = len(gb.buf)
+}
+
+// Range iterates over the elements of the buffer, skipping the gap.
+// The yield function receives the index and value, and can stop iteration by returning false.
+func (gb *GapBuffer[T]) Range(f func(int, T) bool) {
+	for i := 0; i < gb.Len(); i++ {
+		if !f(i, gb.buf[gb.phys(i)]) {
+			break
+		}
+	}
+}
+
+// ReverseRange iterates over the elements from the last one to the first one.
+func (gb *GapBuffer[T]) ReverseRange(f func(int, T) bool) {
+	for i := gb.Len() - 1; i >= 0; i-- {
+		if !f(i, gb.buf[gb.phys(i)]) {
+			break
+		}
+	}
+}
+
+// Index finds the first occurrence of the specified value.
+// Uses the strategy set with SetEquality, or the default comparison for the type.
+// Returns -1 if the value is not found.
+func (gb *GapBuffer[T]) Index(v T) int {
+	eq := gb.equal()
+	if i := slices.IndexFunc(gb.buf[:gb.gapStart], func(t T) bool { return eq(v, t) }); i != -1 {
+		return i
+	}
+	if i := slices.IndexFunc(gb.buf[gb.gapEnd:], func(t T) bool { return eq(v, t) }); i != -1 {
+		return i + gb.gapStart
+	}
+	return -1
+}
+
+// LastIndex finds the last occurrence of the specified value.
+// Returns -1 if the value is not found.
+func (gb *GapBuffer[T]) LastIndex(v T) (index int) {
+	index = -1
+	eq := gb.equal()
+	gb.ReverseRange(func(i int, t T) bool {
+		if eq(v, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// Contains checks if the buffer includes the specified value.
+func (gb *GapBuffer[T]) Contains(v T) bool {
+	return gb.Index(v) != -1
+}
+
+// Remove finds and removes the first occurrence of the specified value.
+func (gb *GapBuffer[T]) Remove(v T) {
+	gb.Pop(gb.Index(v))
+}
+
+// RemoveLast finds and removes the last occurrence of the specified value.
+func (gb *GapBuffer[T]) RemoveLast(v T) {
+	gb.Pop(gb.LastIndex(v))
+}
+
+// compact moves the gap to the end, leaving every element in buf[:gapStart].
+func (gb *GapBuffer[T]) compact() []T {
+	gb.MoveGap(gb.Len())
+	return gb.buf[:gb.gapStart]
+}
+
+// Reverse changes the order of elements in the buffer to their reverse.
+func (gb *GapBuffer[T]) Reverse() {
+	slices.Reverse(gb.compact())
+}
+
+// SortFunc allows custom sorting using a comparison function.
+func (gb *GapBuffer[T]) SortFunc(f func(a, b T) int) {
+	slices.SortFunc(gb.compact(), f)
+}
+
+// SortStableFunc sorts the buffer with a comparison function,
+// keeping the original order of equal elements.
+func (gb *GapBuffer[T]) SortStableFunc(f func(a, b T) int) {
+	slices.SortStableFunc(gb.compact(), f)
+}
+
+// Filter removes elements that do not match the provided predicate function.
+// Keeps only elements for which the function returns true.
+func (gb *GapBuffer[T]) Filter(f func(T) (pass bool)) {
+	s := gb.compact()
+	kept := s[:0]
+	for _, v := range s {
+		if f(v) {
+			kept = append(kept, v)
+		}
+	}
+	clear(s[len(kept):])
+	gb.gapStart = len(kept)
+}
+
+// RemoveDuplicates eliminates duplicate elements, keeping only unique values.
+// Preserves the order of first occurrences.
+func (gb *GapBuffer[T]) RemoveDuplicates() {
+	gb.Filter(uniqueFunc(gb.eq))
+}
+
+// SliceRight is equal to slice[:i].
+func (gb *GapBuffer[T]) SliceRight(i int) {
+	gb.Delete(i, gb.Len())
+}
+
+// SliceLeft is equal to slice[i:].
+func (gb *GapBuffer[T]) SliceLeft(i int) {
+	gb.Delete(0, i)
+}
+
+// SliceRange is equal to slice[i:j].
+func (gb *GapBuffer[T]) SliceRange(i, j int) {
+	gb.SliceRight(j)
+	gb.SliceLeft(i)
+}
+
+// Equal compares the buffer with a slice for equality.
+// Uses the same comparison strategy as Index.
+func (gb *GapBuffer[T]) Equal(v []T) bool {
+	return gb.EqualFunc(v, gb.equal())
+}
+
+// EqualFunc allows custom equality comparison using a provided function.
+func (gb *GapBuffer[T]) EqualFunc(v []T, f func(e1, e2 T) bool) bool {
+	if len(v) != gb.Len() {
+		return false
+	}
+	return slices.EqualFunc(gb.buf[:gb.gapStart], v[:gb.gapStart], f) &&
+		slices.EqualFunc(gb.buf[gb.gapEnd:], v[gb.gapStart:], f)
+}
+
+// EqualSlicerFunc compares the buffer with another Slicer using a custom comparison function.
+func (gb *GapBuffer[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(gb, v, f)
+}
+
+// EqualSlicer compares the buffer with another Slicer.
+// Uses the same comparison strategy as Index.
+func (gb *GapBuffer[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return gb.EqualSlicerFunc(v, gb.equal())
+}
+
+// String returns a string representation of the buffer.
+func (gb *GapBuffer[T]) String() string {
+	return makeString(gb.Range, gb.Len())
+}
+
+// Clone creates a copy of the buffer, preserving the cursor position.
+func (gb *GapBuffer[T]) Clone() *GapBuffer[T] {
+	return &GapBuffer[T]{
+		buf:      slices.Clone(gb.buf),
+		gapStart: gb.gapStart,
+		gapEnd:   gb.gapEnd,
+		eq:       gb.eq,
+	}
+}
@@ -0,0 +1,353 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// IndexedSlice is a Slice of comparable elements that maintains a
+// value → positions index, making Index, LastIndex, Contains and
+// the lookup of Remove and RemoveLast O(1) on average.
+//
+// The index is kept in sync by every method of the type. Operations that
+// reorder the whole slice (SortFunc, Reverse, Filter...) only mark it as stale,
+// and it is rebuilt once on the next lookup.
+//
+// Insert and Delete in the middle of the slice renumber the positions
+// that follow, which costs O(distinct values + moved positions).
+//
+// Elements are always compared with the == operator.
+// Writing through the slice returned by S bypasses the index;
+// call Reindex after doing so.
+type IndexedSlice[T comparable] struct {
+	s         Slice[T]
+	positions positionIndex[T] // Ascending positions of each value
+	stale     bool             // The index must be rebuilt before the next lookup
+	detached  bool             // A pointer from SliceP is outstanding; lookups scan until Reindex
+}
+
+// NewIndexedSlice creates a new IndexedSlice instance with the provided elements.
+//
+// Example:
+//
+//	ids := NewIndexedSlice(10, 20, 30, 20)
+//	ids.LastIndex(20) // 3, without scanning the slice
+func NewIndexedSlice[T comparable](slice ...T) *IndexedSlice[T] {
+	s := &IndexedSlice[T]{s: Slice[T]{slice: slices.Clone(slice)}}
+	s.Reindex()
+
+	return s
+}
+
+// Reindex rebuilds the index from the current elements.
+// It also turns the index back on after SliceP.
+func (s *IndexedSlice[T]) Reindex() {
+	s.positions = make(positionIndex[T])
+	for i, v := range s.s.slice {
+		s.positions[v] = append(s.positions[v], i)
+	}
+	s.stale = false
+	s.detached = false
+}
+
+// lookup returns the positions of v, rebuilding the index if needed.
+// While a pointer from SliceP is outstanding, it scans the slice instead.
+func (s *IndexedSlice[T]) lookup(v T) []int {
+	if s.detached {
+		var p []int
+		for i, e := range s.s.slice {
+			if e == v {
+				p = append(p, i)
+			}
+		}
+		return p
+	}
+	if s.stale {
+		s.Reindex()
+	}
+	return s.positions[v]
+}
+
+// invalidate marks the index as stale.
+func (s *IndexedSlice[T]) invalidate() {
+	s.stale = true
+	s.positions = nil
+}
+
+// IndexSize returns the number of distinct values held by the index.
+func (s *IndexedSlice[T]) IndexSize() int {
+	if s.detached {
+		seen := make(map[T]struct{})
+		for _, v := range s.s.slice {
+			seen[v] = struct{}{}
+		}
+		return len(seen)
+	}
+	if s.stale {
+		s.Reindex()
+	}
+	return len(s.positions)
+}
+
+// Positions returns the ascending positions of every occurrence of v.
+func (s *IndexedSlice[T]) Positions(v T) []int {
+	return slices.Clone(s.lookup(v))
+}
+
+// Index returns the position of the first occurrence of v, or -1 if not found.
+func (s *IndexedSlice[T]) Index(v T) int {
+	if p := s.lookup(v); len(p) > 0 {
+		return p[0]
+	}
+	return -1
+}
+
+// LastIndex returns the position of the last occurrence of v, or -1 if not found.
+func (s *IndexedSlice[T]) LastIndex(v T) int {
+	if p := s.lookup(v); len(p) > 0 {
+		return p[len(p)-1]
+	}
+	return -1
+}
+
+// Contains checks if the slice includes the specified value.
+func (s *IndexedSlice[T]) Contains(v T) bool {
+	return len(s.lookup(v)) > 0
+}
+
+// Count returns the number of occurrences of v.
+func (s *IndexedSlice[T]) Count(v T) int {
+	return len(s.lookup(v))
+}
+
+// At returns the element at the specified index.
+// Panics if the index is out of bounds.
+func (s *IndexedSlice[T]) At(i int) T {
+	return s.s.At(i)
+}
+
+// S returns the underlying built-in slice.
+// Call Reindex after modifying its elements.
+func (s *IndexedSlice[T]) S() []T {
+	return s.s.S()
+}
+
+// SliceP returns a pointer to the underlying slice.
+// Since the slice may be modified through it at any time, the index is
+// turned off and lookups scan the slice until Reindex is called.
+func (s *IndexedSlice[T]) SliceP() *[]T {
+	s.invalidate()
+	s.detached = true
+	return s.s.SliceP()
+}
+
+// sliceP gives read-only access to the storage for internal helpers.
+func (s *IndexedSlice[T]) sliceP() *[]T {
+	return s.s.sliceP()
+}
+
+// Append adds one or more elements to the end of the slice.
+func (s *IndexedSlice[T]) Append(items ...T) {
+	n := s.s.Len()
+	s.s.Append(items...)
+	if s.stale {
+		return
+	}
+	for k, v := range items {
+		s.positions[v] = append(s.positions[v], n+k)
+	}
+}
+
+// Cap returns the capacity of the underlying slice.
+func (s *IndexedSlice[T]) Cap() int {
+	return s.s.Cap()
+}
+
+// Set replaces the element at the specified index.
+func (s *IndexedSlice[T]) Set(i int, v T) {
+	old := s.s.At(i)
+	s.s.Set(i, v)
+	if s.stale || old == v {
+		return
+	}
+	s.positions.drop(old, i)
+	s.positions.add(v, i)
+}
+
+// Clear removes all elements from the slice and the index.
+func (s *IndexedSlice[T]) Clear() {
+	s.s.Clear()
+	s.positions = make(positionIndex[T])
+	s.stale = s.detached
+}
+
+// Clone creates a copy of the slice and its index.
+func (s *IndexedSlice[T]) Clone() *IndexedSlice[T] {
+	return NewIndexedSlice(s.s.slice...)
+}
+
+// CloneS returns a clone of the underlying slice.
+func (s *IndexedSlice[T]) CloneS() []T {
+	return s.s.CloneS()
+}
+
+// Insert adds one or more elements at the specified index.
+// Panics if the index is out of bounds.
+func (s *IndexedSlice[T]) Insert(index int, items ...T) {
+	if index == s.s.Len() {
+		s.Append(items...)
+		return
+	}
+
+	s.s.Insert(index, items...)
+	if s.stale {
+		return
+	}
+	s.positions.shift(index, len(items))
+	for k, v := range items {
+		s.positions.add(v, index+k)
+	}
+}
+
+// Delete removes elements from the slice between indices i and j.
+func (s *IndexedSlice[T]) Delete(i, j int) {
+	removed := s.s.slice[i:j:j]
+	if !s.stale {
+		for k, v := range removed {
+			s.positions.drop(v, i+k)
+		}
+		// Nothing follows a tail delete.
+		if j < s.s.Len() {
+			s.positions.shift(j, i-j)
+		}
+	}
+	s.s.Delete(i, j)
+}
+
+// Pop removes the element at the specified index.
+func (s *IndexedSlice[T]) Pop(index int) {
+	s.Delete(index, index+1)
+}
+
+// Remove finds and removes the first occurrence of the specified value.
+func (s *IndexedSlice[T]) Remove(v T) {
+	s.Pop(s.Index(v))
+}
+
+// RemoveLast finds and removes the last occurrence of the specified value.
+func (s *IndexedSlice[T]) RemoveLast(v T) {
+	s.Pop(s.LastIndex(v))
+}
+
+// Reverse changes the order of elements in the slice to their reverse.
+func (s *IndexedSlice[T]) Reverse() {
+	s.s.Reverse()
+	s.invalidate()
+}
+
+// IsEmpty checks if the slice contains no elements.
+func (s *IndexedSlice[T]) IsEmpty() bool {
+	return s.s.IsEmpty()
+}
+
+// Len returns the number of elements in the slice.
+func (s *IndexedSlice[T]) Len() int {
+	return s.s.Len()
+}
+
+// InRange checks if the given index is within the slice's bounds.
+func (s *IndexedSlice[T]) InRange(i int) bool {
+	return s.s.InRange(i)
+}
+
+// RemoveDuplicates eliminates duplicate elements, keeping only unique values.
+// Preserves the order of first occurrences.
+func (s *IndexedSlice[T]) RemoveDuplicates() {
+	s.s.RemoveDuplicates()
+	s.invalidate()
+}
+
+// Equal compares the slice with another slice for equality.
+func (s *IndexedSlice[T]) Equal(v []T) bool {
+	return slices.Equal(s.s.slice, v)
+}
+
+// EqualFunc allows custom equality comparison using a provided function.
+func (s *IndexedSlice[T]) EqualFunc(v []T, f func(e1, e2 T) bool) bool {
+	return s.s.EqualFunc(v, f)
+}
+
+// EqualSlicer compares the current slice with another Slicer.
+func (s *IndexedSlice[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return s.EqualSlicerFunc(v, comparableEqual2[T])
+}
+
+// EqualSlicerFunc compares the current slice with another Slicer using a custom comparison function.
+func (s *IndexedSlice[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(s, v, f)
+}
+
+// SortFunc allows custom sorting using a comparison function.
+func (s *IndexedSlice[T]) SortFunc(f func(a, b T) int) {
+	s.s.SortFunc(f)
+	s.invalidate()
+}
+
+// SortStableFunc sorts the slice with a comparison function,
+// keeping the original order of equal elements.
+func (s *IndexedSlice[T]) SortStableFunc(f func(a, b T) int) {
+	s.s.SortStableFunc(f)
+	s.invalidate()
+}
+
+// Filter removes elements that do not match the provided predicate function.
+func (s *IndexedSlice[T]) Filter(f func(T) (pass bool)) {
+	s.s.Filter(f)
+	s.invalidate()
+}
+
+// Range provides an iterator-like functionality for the slice.
+func (s *IndexedSlice[T]) Range(yield func(k int, v T) bool) {
+	s.s.Range(yield)
+}
+
+// ReverseRange iterates over the slice from the last element to the first.
+func (s *IndexedSlice[T]) ReverseRange(f func(int, T) bool) {
+	s.s.ReverseRange(f)
+}
+
+// String returns a string representation of the slice.
+func (s *IndexedSlice[T]) String() string {
+	return s.s.String()
+}
+
+// Grow increases the slice's capacity to accommodate more elements.
+func (s *IndexedSlice[T]) Grow(n int) {
+	s.s.Grow(n)
+}
+
+// Clip reduces the slice's capacity to its length.
+func (s *IndexedSlice[T]) Clip() {
+	s.s.Clip()
+}
+
+// SliceRight is equal to slice[:i].
+func (s *IndexedSlice[T]) SliceRight(i int) {
+	s.Delete(i, s.s.Len())
+}
+
+// SliceLeft is equal to slice[i:].
+func (s *IndexedSlice[T]) SliceLeft(i int) {
+	s.s.SliceLeft(i)
+	s.invalidate()
+}
+
+// SliceRange is equal to slice[i:j].
+func (s *IndexedSlice[T]) SliceRange(i, j int) {
+	s.SliceRight(j)
+	s.SliceLeft(i)
+}
+
+// View returns a live window onto the elements [i:j]; see the view type.
+func (s *IndexedSlice[T]) View(i, j int) Slicer[T] {
+	return newView[T](s, i, j)
+}
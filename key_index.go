@@ -0,0 +1,445 @@
+package slicelib
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrUniqueViolation is returned when a mutation would store two elements
+// with the same key in a unique index.
+var ErrUniqueViolation = errors.New("slicelib: unique index violation")
+
+// keyIndex is the type-erased view of a keyIndexOf, used by KeyIndexed
+// to keep every index in sync regardless of its key type.
+type keyIndex[T any] interface {
+	reset()
+	add(v T, pos int)
+	drop(v T, pos int)
+	shift(from, delta int)
+	check(items []T, skip int) error
+	violation() error
+}
+
+// keyIndexOf maps the keys extracted from the elements to their positions.
+type keyIndexOf[T any, K comparable] struct {
+	name      string
+	key       func(T) K
+	unique    bool
+	positions positionIndex[K]
+}
+
+func (x *keyIndexOf[T, K]) reset() {
+	x.positions = make(positionIndex[K])
+}
+
+func (x *keyIndexOf[T, K]) add(v T, pos int) {
+	x.positions.add(x.key(v), pos)
+}
+
+func (x *keyIndexOf[T, K]) drop(v T, pos int) {
+	x.positions.drop(x.key(v), pos)
+}
+
+func (x *keyIndexOf[T, K]) shift(from, delta int) {
+	x.positions.shift(from, delta)
+}
+
+// check reports whether storing items would violate the uniqueness of the index.
+// The element at position skip is ignored, as it is being replaced.
+func (x *keyIndexOf[T, K]) check(items []T, skip int) error {
+	if !x.unique {
+		return nil
+	}
+
+	seen := make(map[K]bool, len(items))
+	for _, v := range items {
+		k := x.key(v)
+		p := x.positions[k]
+		if seen[k] || len(p) > 1 || len(p) == 1 && p[0] != skip {
+			return fmt.Errorf("%w: index %q, key %v", ErrUniqueViolation, x.name, k)
+		}
+		seen[k] = true
+	}
+	return nil
+}
+
+// violation reports whether the elements already in the index share a key
+// while it is unique.
+func (x *keyIndexOf[T, K]) violation() error {
+	if !x.unique {
+		return nil
+	}
+
+	for k, p := range x.positions {
+		if len(p) > 1 {
+			return fmt.Errorf("%w: index %q, key %v", ErrUniqueViolation, x.name, k)
+		}
+	}
+	return nil
+}
+
+// KeyIndexed wraps a Slicer (such as a Slice or a LinkedList) maintaining
+// secondary indexes over keys derived from its elements, for example the ID
+// or the status of a struct.
+//
+// Indexes are added with AddIndex or AddUniqueIndex and queried with Lookup
+// and LookupValues. KeyIndexed owns the wrapped Slicer and only exposes it
+// read-only, so every mutation goes through its methods, which keep the
+// indexes in sync automatically.
+//
+// Append, Insert and Set return ErrUniqueViolation, leaving the elements
+// untouched, when they would break a unique index.
+type KeyIndexed[T any] struct {
+	s       Slicer[T]
+	indexes map[string]keyIndex[T]
+	stale   bool  // The indexes must be rebuilt before the next lookup
+	err     error // Unique index violation found by the last rebuild
+}
+
+// NewKeyIndexed creates a KeyIndexed that takes ownership of s.
+// s must not be modified directly afterwards.
+//
+// Example:
+//
+//	orders := NewKeyIndexed[Order](NewSlice(list...))
+//	AddUniqueIndex(orders, "id", func(o Order) int { return o.ID })
+//	AddIndex(orders, "status", func(o Order) string { return o.Status })
+//	pending := LookupValues(orders, "status", "pending")
+func NewKeyIndexed[T any](s Slicer[T]) *KeyIndexed[T] {
+	return &KeyIndexed[T]{s: s, indexes: make(map[string]keyIndex[T])}
+}
+
+// AddIndex adds a non-unique index called name over the keys returned by key.
+// Panics if an index with the same name already exists.
+func AddIndex[T any, K comparable](s *KeyIndexed[T], name string, key func(T) K) {
+	// A non-unique index cannot fail to build.
+	_ = addIndex(s, name, key, false)
+}
+
+// AddUniqueIndex adds an index called name over the keys returned by key,
+// that rejects elements sharing a key.
+// Returns ErrUniqueViolation, without adding the index, if the current elements already share a key.
+// Panics if an index with the same name already exists.
+func AddUniqueIndex[T any, K comparable](s *KeyIndexed[T], name string, key func(T) K) error {
+	return addIndex(s, name, key, true)
+}
+
+func addIndex[T any, K comparable](s *KeyIndexed[T], name string, key func(T) K, unique bool) error {
+	if _, ok := s.indexes[name]; ok {
+		panic(fmt.Sprintf("slicelib: index %q already exists", name))
+	}
+
+	x := &keyIndexOf[T, K]{name: name, key: key, unique: unique}
+	if err := buildIndex(s.s, x); err != nil {
+		return err
+	}
+	s.indexes[name] = x
+	return nil
+}
+
+// indexNamed returns the index called name, rebuilding the indexes if needed.
+// Panics if there is no such index or if its key type is not K.
+func indexNamed[T any, K comparable](s *KeyIndexed[T], name string) *keyIndexOf[T, K] {
+	i, ok := s.indexes[name]
+	if !ok {
+		panic(fmt.Sprintf("slicelib: unknown index %q", name))
+	}
+	x, ok := i.(*keyIndexOf[T, K])
+	if !ok {
+		panic(fmt.Sprintf("slicelib: index %q has a different key type", name))
+	}
+
+	s.refresh()
+	return x
+}
+
+// Lookup returns the ascending positions of the elements whose key in the index called name is key.
+// Panics if there is no such index.
+func Lookup[T any, K comparable](s *KeyIndexed[T], name string, key K) []int {
+	return slices.Clone(indexNamed[T, K](s, name).positions[key])
+}
+
+// LookupValues returns the elements whose key in the index called name is key, in order.
+// Panics if there is no such index.
+func LookupValues[T any, K comparable](s *KeyIndexed[T], name string, key K) []T {
+	p := indexNamed[T, K](s, name).positions[key]
+	values := make([]T, len(p))
+	for i, pos := range p {
+		values[i] = s.s.At(pos)
+	}
+	return values
+}
+
+// DropIndex removes the index called name, if any.
+func (s *KeyIndexed[T]) DropIndex(name string) {
+	delete(s.indexes, name)
+}
+
+// buildIndex fills x with the elements of s.
+// The index is always complete; the error reports whether its elements
+// share a key while it is unique.
+func buildIndex[T any](s Ranger[T], x keyIndex[T]) error {
+	x.reset()
+	s.Range(func(i int, v T) bool {
+		x.add(v, i)
+		return true
+	})
+	return x.violation()
+}
+
+// Reindex rebuilds every index from the current elements.
+//
+// It is only needed if the Slicer given to NewKeyIndexed was modified
+// directly, and returns ErrUniqueViolation if its elements now share a key
+// of a unique index. The indexes are still rebuilt and Lookup stays accurate,
+// but Append, Insert and Set keep returning the error until a Reindex succeeds.
+func (s *KeyIndexed[T]) Reindex() error {
+	s.stale = false
+	s.err = nil
+	for _, x := range s.indexes {
+		if err := buildIndex(s.s, x); err != nil && s.err == nil {
+			s.err = err
+		}
+	}
+	return s.err
+}
+
+// refresh rebuilds the indexes if they are stale.
+// A violation found while doing so is kept in s.err and reported by check.
+func (s *KeyIndexed[T]) refresh() {
+	if s.stale {
+		s.Reindex()
+	}
+}
+
+// check reports whether storing items would violate a unique index.
+func (s *KeyIndexed[T]) check(items []T, skip int) error {
+	s.refresh()
+	if s.err != nil {
+		return s.err
+	}
+	for _, x := range s.indexes {
+		if err := x.check(items, skip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Slicer returns a read-only view of the wrapped Slicer.
+// Mutations must go through KeyIndexed to keep the indexes in sync.
+func (s *KeyIndexed[T]) Slicer() ReadSlicer[T] {
+	return ReadOnly[T](s.s)
+}
+
+// Append adds one or more elements to the end of the sequence.
+// Returns ErrUniqueViolation, adding nothing, if they break a unique index.
+func (s *KeyIndexed[T]) Append(items ...T) error {
+	if err := s.check(items, -1); err != nil {
+		return err
+	}
+
+	n := s.s.Len()
+	s.s.Append(items...)
+	for _, x := range s.indexes {
+		for i, v := range items {
+			x.add(v, n+i)
+		}
+	}
+	return nil
+}
+
+// Insert adds one or more elements at the specified index.
+// Returns ErrUniqueViolation, adding nothing, if they break a unique index.
+//
+// Panics if the index is out of bounds.
+func (s *KeyIndexed[T]) Insert(index int, items ...T) error {
+	if err := s.check(items, -1); err != nil {
+		return err
+	}
+
+	s.s.Insert(index, items...)
+	for _, x := range s.indexes {
+		x.shift(index, len(items))
+		for i, v := range items {
+			x.add(v, index+i)
+		}
+	}
+	return nil
+}
+
+// Set replaces the element at the specified index.
+// Returns ErrUniqueViolation, leaving the element untouched, if v breaks a unique index.
+func (s *KeyIndexed[T]) Set(i int, v T) error {
+	old := s.s.At(i)
+	if err := s.check([]T{v}, i); err != nil {
+		return err
+	}
+
+	s.s.Set(i, v)
+	for _, x := range s.indexes {
+		x.drop(old, i)
+		x.add(v, i)
+	}
+	return nil
+}
+
+// Delete removes the elements between indices i and j.
+func (s *KeyIndexed[T]) Delete(i, j int) {
+	if i < 0 || j > s.s.Len() || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+
+	s.refresh()
+	s.s.Range(func(k int, v T) bool {
+		if k >= j {
+			return false
+		}
+		if k >= i {
+			for _, x := range s.indexes {
+				x.drop(v, k)
+			}
+		}
+		return true
+	})
+	for _, x := range s.indexes {
+		x.shift(j, i-j)
+	}
+	s.s.Delete(i, j)
+}
+
+// Pop removes the element at the specified index.
+func (s *KeyIndexed[T]) Pop(i int) {
+	if !s.s.InRange(i) {
+		outOfRangePanic(i, s.s.Len())
+	}
+	s.Delete(i, i+1)
+}
+
+// Remove finds and removes the first occurrence of the specified value.
+func (s *KeyIndexed[T]) Remove(v T) {
+	s.Pop(s.s.Index(v))
+}
+
+// RemoveLast finds and removes the last occurrence of the specified value.
+func (s *KeyIndexed[T]) RemoveLast(v T) {
+	s.Pop(s.s.LastIndex(v))
+}
+
+// Clear removes all the elements.
+func (s *KeyIndexed[T]) Clear() {
+	s.s.Clear()
+	for _, x := range s.indexes {
+		x.reset()
+	}
+	s.stale = false
+	s.err = nil
+}
+
+// RemoveDuplicates eliminates duplicate elements, keeping only unique values.
+func (s *KeyIndexed[T]) RemoveDuplicates() {
+	s.s.RemoveDuplicates()
+	s.stale = true
+}
+
+// Filter removes elements that do not match the provided predicate function.
+func (s *KeyIndexed[T]) Filter(f func(T) (pass bool)) {
+	s.s.Filter(f)
+	s.stale = true
+}
+
+// SortFunc allows custom sorting using a comparison function.
+func (s *KeyIndexed[T]) SortFunc(f func(a, b T) int) {
+	s.s.SortFunc(f)
+	s.stale = true
+}
+
+// SortStableFunc sorts the elements with a comparison function,
+// keeping the original order of equal elements.
+func (s *KeyIndexed[T]) SortStableFunc(f func(a, b T) int) {
+	s.s.SortStableFunc(f)
+	s.stale = true
+}
+
+// Reverse changes the order of elements to their reverse.
+func (s *KeyIndexed[T]) Reverse() {
+	s.s.Reverse()
+	s.stale = true
+}
+
+// SliceRight is equal to slice[:i].
+func (s *KeyIndexed[T]) SliceRight(i int) {
+	s.Delete(i, s.s.Len())
+}
+
+// SliceLeft is equal to slice[i:].
+func (s *KeyIndexed[T]) SliceLeft(i int) {
+	s.Delete(0, i)
+}
+
+// SliceRange is equal to slice[i:j].
+func (s *KeyIndexed[T]) SliceRange(i, j int) {
+	s.SliceRight(j)
+	s.SliceLeft(i)
+}
+
+func (s *KeyIndexed[T]) At(i int) T {
+	return s.s.At(i)
+}
+
+func (s *KeyIndexed[T]) S() []T {
+	return s.s.S()
+}
+
+func (s *KeyIndexed[T]) Len() int {
+	return s.s.Len()
+}
+
+func (s *KeyIndexed[T]) String() string {
+	return s.s.String()
+}
+
+func (s *KeyIndexed[T]) Range(f func(int, T) bool) {
+	s.s.Range(f)
+}
+
+func (s *KeyIndexed[T]) ReverseRange(f func(int, T) bool) {
+	s.s.ReverseRange(f)
+}
+
+func (s *KeyIndexed[T]) Index(v T) int {
+	return s.s.Index(v)
+}
+
+func (s *KeyIndexed[T]) LastIndex(v T) int {
+	return s.s.LastIndex(v)
+}
+
+func (s *KeyIndexed[T]) Contains(v T) bool {
+	return s.s.Contains(v)
+}
+
+func (s *KeyIndexed[T]) IsEmpty() bool {
+	return s.s.IsEmpty()
+}
+
+func (s *KeyIndexed[T]) InRange(i int) bool {
+	return s.s.InRange(i)
+}
+
+func (s *KeyIndexed[T]) Equal(v []T) bool {
+	return s.s.Equal(v)
+}
+
+func (s *KeyIndexed[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return s.s.EqualSlicer(v)
+}
+
+func (s *KeyIndexed[T]) EqualFunc(v []T, f func(T, T) bool) bool {
+	return s.s.EqualFunc(v, f)
+}
+
+func (s *KeyIndexed[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
+	return s.s.EqualSlicerFunc(v, f)
+}
@@ -1,7 +1,6 @@
 package slicelib
 
 import (
-	"reflect"
 	"slices"
 )
 
@@ -18,6 +17,8 @@ type LinkedList[T any] struct {
 	head *node[T] // First node of the list
 	tail *node[T] // Last node of the list
 	len  int      // Total number of elements in the list
+
+	eq EqualityStrategy[T] // Custom equality, nil for the default one
 }
 
 func (ll *LinkedList[T]) makeNodeChain(items ...T) (h, t *node[T], l int) {
@@ -125,21 +126,21 @@ func (ll *LinkedList[T]) refreshLen() {
 	ll.len = l
 }
 
+// equality returns the EqualityStrategy set on the LinkedList, if any.
+func (ll *LinkedList[T]) equality() EqualityStrategy[T] {
+	return ll.eq
+}
+
+// equal returns the comparison strategy of the list.
+func (ll *LinkedList[T]) equal() func(T, T) bool {
+	return strategyOr(ll.eq)
+}
+
 func (ll *LinkedList[T]) index(iter func(func(int, *node[T]) bool), val T) (index int) {
 	index = -1
-	if reflect.TypeFor[T]().Comparable() {
-		iter(func(i int, n *node[T]) bool {
-			if any(n.data) == any(val) {
-				index = i
-				return false
-			}
-			return true
-		})
-		return
-	}
-
+	eq := ll.equal()
 	iter(func(i int, n *node[T]) bool {
-		if reflect.DeepEqual(val, n.data) {
+		if eq(val, n.data) {
 			index = i
 			return false
 		}
@@ -161,6 +162,25 @@ func NewLinkedList[T any](slice ...T) *LinkedList[T] {
 	return ll
 }
 
+// NewLinkedListWithEquality creates a new LinkedList that compares its elements with eq.
+//
+// Example:
+//
+//	list := NewLinkedListWithEquality(strings.EqualFold, "a", "A")
+func NewLinkedListWithEquality[T any](eq EqualityStrategy[T], slice ...T) *LinkedList[T] {
+	ll := NewLinkedList(slice...)
+	ll.SetEquality(eq)
+
+	return ll
+}
+
+// SetEquality sets the strategy used to compare elements in Index, LastIndex,
+// Contains, Remove, RemoveLast, RemoveDuplicates and Equal.
+// A nil strategy restores the default comparison.
+func (ll *LinkedList[T]) SetEquality(eq EqualityStrategy[T]) {
+	ll.eq = eq
+}
+
 // Range iterates through the list, allowing operations on each element.
 // Provides a functional iteration approach similar to slice range.
 //
@@ -241,22 +261,9 @@ func (ll *LinkedList[T]) InRange(i int) bool {
 }
 
 // Contains checks if the list includes a specific value.
-// Uses appropriate comparison strategy based on type comparability.
-func (ll *LinkedList[T]) Contains(v T) (contains bool) {
-	if reflect.TypeFor[T]().Comparable() {
-		ll.Range(func(_ int, t T) bool {
-			contains = any(t) == any(v)
-			return !contains
-		})
-
-		return
-	}
-	ll.Range(func(_ int, t T) bool {
-		contains = reflect.DeepEqual(t, v)
-		return !contains
-	})
-
-	return
+// Uses the same comparison strategy as Index.
+func (ll *LinkedList[T]) Contains(v T) bool {
+	return ll.Index(v) != -1
 }
 
 // Index finds the last occurrence of a value in the list.
@@ -294,59 +301,52 @@ func (ll *LinkedList[T]) Clear() {
 }
 
 // Delete removes elements between indices i and j.
-// Panics if either index is out of range.
+// Follows the behavior of slices.Delete.
+//
+// Panics if the range is out of bounds.
 func (ll *LinkedList[T]) Delete(i, j int) {
-	if j == ll.len && i == 0 {
-		ll.Clear()
-		return
+	if i < 0 || j > ll.len || i > j {
+		panic("slicelib: slice bounds out of range")
 	}
-
-	if j == ll.len {
-		start := ll.at(i)
-		prev := start.previous
-
-		if prev != nil {
-			prev.next = nil
-		} else {
-			start.next = nil
-		}
-
-		ll.len -= j - i
+	if i == j {
 		return
 	}
 
 	start := ll.at(i)
-	end := ll.at(j)
+	// end is the first node kept after the deleted range.
+	var end *node[T]
+	if j < ll.len {
+		end = ll.at(j)
+	}
 
-	if start.previous != nil {
-		start.previous.next = end
+	prev := start.previous
+	if prev != nil {
+		prev.next = end
+	} else {
+		ll.head = end
 	}
-	if end.previous != nil {
-		end.previous = start.previous
+	if end != nil {
+		end.previous = prev
+	} else {
+		ll.tail = prev
 	}
 
 	ll.len -= j - i
 }
 
 // Equal compares the list with a slice for equality.
-// Uses deep or standard comparison based on type comparability.
+// Uses the same comparison strategy as Index.
 func (ll *LinkedList[T]) Equal(s []T) bool {
-	if reflect.TypeFor[T]().Comparable() {
-		return ll.EqualFunc(s, comparableEqual2[T])
-	}
-
-	return ll.EqualFunc(s, deepEqual2[T])
+	return ll.EqualFunc(s, ll.equal())
 }
 
 // EqualFunc allows custom comparison of the list with a slice.
 func (ll *LinkedList[T]) EqualFunc(s []T, f func(T, T) bool) (eq bool) {
-	if len(s) != ll.Len() || cap(s) != len(s) {
+	if len(s) != ll.Len() {
 		return false
 	}
-	if len(s) == 0 && ll.len == 0 {
-		return true
-	}
 
+	eq = true
 	ll.Range(func(i int, t T) bool {
 		eq = f(s[i], t)
 		return eq
@@ -356,17 +356,14 @@ func (ll *LinkedList[T]) EqualFunc(s []T, f func(T, T) bool) (eq bool) {
 }
 
 // EqualSlicerFunc compares the list with another Slicer using a custom function.
-func (ll *LinkedList[T]) EqualSlicerFunc(s Slicer[T], f func(T, T) bool) bool {
+func (ll *LinkedList[T]) EqualSlicerFunc(s ReadSlicer[T], f func(T, T) bool) bool {
 	return equalSlicersFunc(ll, s, f)
 }
 
 // EqualSlicer compares the list with another Slicer.
-// Uses appropriate comparison strategy based on type comparability.
-func (ll *LinkedList[T]) EqualSlicer(s Slicer[T]) bool {
-	if reflect.TypeFor[T]().Comparable() {
-		return ll.EqualSlicerFunc(s, comparableEqual2[T])
-	}
-	return ll.EqualSlicerFunc(s, deepEqual2[T])
+// Uses the same comparison strategy as Index.
+func (ll *LinkedList[T]) EqualSlicer(s ReadSlicer[T]) bool {
+	return ll.EqualSlicerFunc(s, ll.equal())
 }
 
 // IsEmpty checks if the list contains no elements.
@@ -374,75 +371,50 @@ func (ll *LinkedList[T]) IsEmpty() bool {
 	return ll.head == nil
 }
 
-// Insert is a placeholder for future implementation of inserting elements at a specific index.
+// Insert adds one or more elements at the specified index.
+// The element previously at that index ends right after the inserted ones.
+//
+// Panics if the index is out of range.
 func (ll *LinkedList[T]) Insert(i int, values ...T) {
-	if ll.len == 0 && i == 0 {
+	if i == ll.len {
 		ll.Append(values...)
 		return
 	}
+	if len(values) == 0 {
+		return
+	}
 
-	var l int
-	var h, t *node[T]
-	if i == ll.len {
-		h, t, l = ll.makeNodeChain(values...)
+	cur := ll.at(i)
+	h, t, l := ll.makeNodeChain(values...)
 
-		h.previous = ll.tail
-		ll.tail.next = h
-		ll.tail = t
+	h.previous = cur.previous
+	if cur.previous != nil {
+		cur.previous.next = h
 	} else {
-		cur := ll.at(i)
-		next := cur.next
-
-		h, t, l = ll.makeNodeChain(values...)
-
-		cur.next = h
-		h.previous = cur
-
-		if next != nil {
-			t.next = next
-			next.previous = t
-		} else {
-			ll.tail = t
-		}
+		ll.head = h
 	}
+	t.next = cur
+	cur.previous = t
 
 	ll.len += l
 }
 
+// RemoveDuplicates eliminates duplicate elements, keeping only unique values.
+// Preserves the order of first occurrences.
+// Uses the same comparison strategy as Index: comparable elements are hashed,
+// while the others (slices, maps, structs holding them...) are compared pairwise.
+//
+// See RemoveDuplicatesFunc and RemoveDuplicatesEqual for more control.
 func (ll *LinkedList[T]) RemoveDuplicates() {
-	seen := make(map[any]bool)
-
-	var j int
-	ll.Range(func(i int, t T) bool {
-		if !seen[t] {
-			seen[t] = true
-			ll.Set(j, ll.At(i))
-			j++
-		}
-
-		return true
-	})
-
-	ll.SliceRight(j)
+	ll.Filter(uniqueFunc(ll.eq))
 }
 
 func (ll *LinkedList[T]) Reverse() {
-	var (
-		prev *node[T]
-		next *node[T]
-	)
-
-	curr := ll.head
-
-	for curr != nil {
-		next = curr.next
-		curr.next = prev
-		prev = curr
-		curr = next
+	for c := ll.head; c != nil; c = c.previous {
+		c.next, c.previous = c.previous, c.next
 	}
 
-	ll.head = prev
-	ll.tail = next
+	ll.head, ll.tail = ll.tail, ll.head
 }
 
 func (ll *LinkedList[T]) Set(i int, v T) {
@@ -456,6 +428,15 @@ func (ll *LinkedList[T]) SortFunc(cmp func(a, b T) int) {
 	ll.Append(slice...)
 }
 
+// SortStableFunc sorts the list with a comparison function,
+// keeping the original order of equal elements.
+func (ll *LinkedList[T]) SortStableFunc(cmp func(a, b T) int) {
+	slice := ll.S()
+	slices.SortStableFunc(slice, cmp)
+	ll.Clear()
+	ll.Append(slice...)
+}
+
 func (ll *LinkedList[T]) SliceLeft(index int) {
 	switch {
 	case index <= 0:
@@ -466,7 +447,8 @@ func (ll *LinkedList[T]) SliceLeft(index int) {
 	}
 
 	n := ll.at(index)
-	n.next.previous = nil
+	n.previous.next = nil
+	n.previous = nil
 	ll.head = n
 	ll.len -= index
 }
@@ -483,6 +465,7 @@ func (ll *LinkedList[T]) SliceRight(index int) {
 	n := ll.at(index)
 	n.previous.next = nil
 	ll.tail = n.previous
+	n.previous = nil
 	ll.len = index
 }
 
@@ -492,7 +475,7 @@ func (ll *LinkedList[T]) SliceRange(i, j int) {
 }
 
 func (ll *LinkedList[T]) Clone() *LinkedList[T] {
-	n := NewLinkedList[T]()
+	n := NewLinkedListWithEquality[T](ll.eq)
 
 	ll.Range(func(_ int, t T) bool {
 		n.Append(t)
@@ -516,3 +499,8 @@ func (ll *LinkedList[T]) Filter(f func(t T) (pass bool)) {
 	})
 	ll.SliceLeft(orgLen)
 }
+
+// View returns a live window onto the elements [i:j]; see the view type.
+func (ll *LinkedList[T]) View(i, j int) Slicer[T] {
+	return newView[T](ll, i, j)
+}
@@ -0,0 +1,184 @@
+package slicelib
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrPatchConflict is returned by Patch when the target does not contain
+// the elements the edit script expects, for example because it has been
+// modified since the script was computed.
+var ErrPatchConflict = errors.New("slicelib: patch conflict")
+
+// Patch applies an edit script computed by Diff to s, turning a copy of
+// the first sequence into the second one.
+//
+// Before modifying s, every EditEqual and EditDelete run is checked against it;
+// if one does not match, s is left untouched and ErrPatchConflict is returned.
+func Patch[T comparable](s Slicer[T], edits []Edit[T]) error {
+	return PatchFunc(s, edits, comparableEqual2[T])
+}
+
+// PatchFunc is like Patch but compares the elements with eq.
+func PatchFunc[T any](s Slicer[T], edits []Edit[T], eq func(a, b T) bool) error {
+	items := collect[T](s)
+
+	var n int
+	for _, e := range edits {
+		if e.Op == EditInsert {
+			continue
+		}
+		if e.A != n || e.A+len(e.Values) > len(items) ||
+			!slices.EqualFunc(items[e.A:e.A+len(e.Values)], e.Values, eq) {
+			return fmt.Errorf("%w: %s run at %d does not match", ErrPatchConflict, e.Op, e.A)
+		}
+		n += len(e.Values)
+	}
+	if n != len(items) {
+		return fmt.Errorf("%w: expected %d elements, found %d", ErrPatchConflict, n, len(items))
+	}
+
+	// Edits are applied from the last one, so the positions of the others stay valid.
+	for k := len(edits) - 1; k >= 0; k-- {
+		switch e := edits[k]; e.Op {
+		case EditInsert:
+			s.Insert(e.A, e.Values...)
+		case EditDelete:
+			s.Delete(e.A, e.A+len(e.Values))
+		}
+	}
+	return nil
+}
+
+// Conflict is a region changed differently by both sides of a three-way merge.
+// Position is the index in the merged result where the resolution of the conflict starts.
+type Conflict[T any] struct {
+	Base, Ours, Theirs []T
+	Position           int
+}
+
+// Resolver decides the elements that replace a conflicting region in a three-way merge.
+type Resolver[T any] func(c Conflict[T]) []T
+
+// TakeOurs resolves conflicts with our version of the region.
+func TakeOurs[T any](c Conflict[T]) []T {
+	return c.Ours
+}
+
+// TakeTheirs resolves conflicts with their version of the region.
+func TakeTheirs[T any](c Conflict[T]) []T {
+	return c.Theirs
+}
+
+// Markers returns a Resolver that keeps both versions of a conflicting region
+// between marker elements, like git does with lines.
+//
+// Example:
+//
+//	Markers("<<<<<<< ours", "=======", ">>>>>>> theirs")
+//	// [<<<<<<< ours, ...ours, =======, ...theirs, >>>>>>> theirs]
+func Markers[T any](start, separator, end T) Resolver[T] {
+	return func(c Conflict[T]) []T {
+		resolved := make([]T, 0, len(c.Ours)+len(c.Theirs)+3)
+		resolved = append(resolved, start)
+		resolved = append(resolved, c.Ours...)
+		resolved = append(resolved, separator)
+		resolved = append(resolved, c.Theirs...)
+		return append(resolved, end)
+	}
+}
+
+// Merge3 merges the changes made by ours and theirs to their common ancestor base.
+//
+// Regions changed by only one side, or identically by both, are merged cleanly.
+// Regions changed differently by both sides are conflicts: they are replaced
+// by the elements returned by resolve (TakeOurs when it is nil) and reported
+// in the returned slice, in order.
+//
+// Example:
+//
+//	merged, conflicts := Merge3[string](base, ours, theirs, Markers("<<<<<<<", "=======", ">>>>>>>"))
+func Merge3[T comparable](base, ours, theirs ReadSlicer[T], resolve Resolver[T]) (*Slice[T], []Conflict[T]) {
+	return Merge3Func(base, ours, theirs, resolve, comparableEqual2[T])
+}
+
+// Merge3Func is like Merge3 but compares the elements with eq.
+func Merge3Func[T any](
+	base, ours, theirs ReadSlicer[T],
+	resolve Resolver[T],
+	eq func(a, b T) bool,
+) (*Slice[T], []Conflict[T]) {
+	if resolve == nil {
+		resolve = TakeOurs[T]
+	}
+
+	b, o, t := collect(base), collect(ours), collect(theirs)
+	// inO[i] and inT[i] are the positions of base[i] in ours and theirs, -1 if it was removed.
+	inO, inT := matches(b, o, eq), matches(b, t, eq)
+
+	var (
+		merged    []T
+		conflicts []Conflict[T]
+		i, j, k   int
+	)
+	for {
+		// Stable region: kept by both sides, with nothing inserted before it.
+		for i < len(b) && inO[i] == j && inT[i] == k {
+			merged = append(merged, b[i])
+			i++
+			j++
+			k++
+		}
+		if i == len(b) && j == len(o) && k == len(t) {
+			break
+		}
+
+		// The unstable region ends at the next element kept by both sides.
+		ni, nj, nk := i, len(o), len(t)
+		for ni < len(b) && (inO[ni] == -1 || inT[ni] == -1) {
+			ni++
+		}
+		if ni < len(b) {
+			nj, nk = inO[ni], inT[ni]
+		}
+
+		bc, oc, tc := b[i:ni], o[j:nj], t[k:nk]
+		switch {
+		case slices.EqualFunc(oc, bc, eq):
+			merged = append(merged, tc...)
+		case slices.EqualFunc(tc, bc, eq), slices.EqualFunc(oc, tc, eq):
+			merged = append(merged, oc...)
+		default:
+			c := Conflict[T]{
+				Base:     slices.Clone(bc),
+				Ours:     slices.Clone(oc),
+				Theirs:   slices.Clone(tc),
+				Position: len(merged),
+			}
+			conflicts = append(conflicts, c)
+			merged = append(merged, resolve(c)...)
+		}
+		i, j, k = ni, nj, nk
+	}
+
+	return &Slice[T]{slice: merged}, conflicts
+}
+
+// matches returns the position in y of every element of x kept by the diff between them,
+// or -1 for the removed ones.
+func matches[T any](x, y []T, eq func(a, b T) bool) []int {
+	pos := make([]int, len(x))
+	for i := range pos {
+		pos[i] = -1
+	}
+	for _, e := range diffSlices(x, y, eq) {
+		if e.Op != EditEqual {
+			continue
+		}
+		for n := range e.Values {
+			pos[e.A+n] = e.B + n
+		}
+	}
+	return pos
+}
@@ -0,0 +1,241 @@
+package slicelib
+
+import (
+	"fmt"
+	"slices"
+)
+
+// LCS returns the longest common subsequence of the slice and v.
+// Uses the same comparison strategy as Index.
+// See LCSFunc.
+func (s ComparableSlice[T]) LCS(v []T) []T {
+	return lcs(s.slice, v, s.equal())
+}
+
+// LevenshteinDistance returns the minimal number of insertions, deletions
+// and substitutions turning the slice into v.
+// Uses the same comparison strategy as Index.
+func (s ComparableSlice[T]) LevenshteinDistance(v []T) int {
+	return levenshtein(s.slice, v, s.equal())
+}
+
+// DamerauLevenshtein is like LevenshteinDistance but also counts the
+// transposition of two adjacent elements as a single edit.
+// Uses the same comparison strategy as Index.
+func (s ComparableSlice[T]) DamerauLevenshtein(v []T) int {
+	return damerauLevenshtein(s.slice, v, s.equal())
+}
+
+// JaccardSimilarity returns the size of the intersection divided by the size
+// of the union of the distinct elements of the slice and v, in [0, 1].
+// Two empty slices are identical, with a similarity of 1.
+// Uses the same comparison strategy as Index.
+func (s ComparableSlice[T]) JaccardSimilarity(v []T) float64 {
+	if !s.usesOperator() {
+		return jaccard(s.slice, v, s.equal())
+	}
+
+	a := make(map[T]bool, len(s.slice))
+	for _, e := range s.slice {
+		a[e] = true
+	}
+	b := make(map[T]bool, len(v))
+	for _, e := range v {
+		b[e] = true
+	}
+
+	var inter int
+	for e := range a {
+		if b[e] {
+			inter++
+		}
+	}
+	return similarity(inter, len(a), len(b))
+}
+
+// HammingDistance returns the number of positions at which the slice and v differ.
+// Returns ErrLengthMismatch if their lengths differ.
+// Uses the same comparison strategy as Index.
+func (s ComparableSlice[T]) HammingDistance(v []T) (int, error) {
+	return hamming(s.slice, v, s.equal())
+}
+
+// LCSFunc returns the longest common subsequence of a and b, comparing the elements with eq.
+//
+// Uses Hirschberg's algorithm: O(n·m) time but only O(n+m) memory.
+func LCSFunc[T any](a, b ReadSlicer[T], eq func(a, b T) bool) []T {
+	return lcs(collect(a), collect(b), eq)
+}
+
+// LevenshteinDistanceFunc returns the minimal number of insertions, deletions
+// and substitutions turning a into b, comparing the elements with eq.
+//
+// Runs in O(n·m) time and O(m) memory.
+func LevenshteinDistanceFunc[T any](a, b ReadSlicer[T], eq func(a, b T) bool) int {
+	return levenshtein(collect(a), collect(b), eq)
+}
+
+// DamerauLevenshteinFunc is like LevenshteinDistanceFunc but also counts the
+// transposition of two adjacent elements as a single edit.
+// It computes the optimal string alignment distance, in which no element
+// is edited more than once.
+//
+// Runs in O(n·m) time and O(m) memory.
+func DamerauLevenshteinFunc[T any](a, b ReadSlicer[T], eq func(a, b T) bool) int {
+	return damerauLevenshtein(collect(a), collect(b), eq)
+}
+
+// JaccardSimilarityFunc returns the Jaccard similarity of the distinct elements
+// of a and b, comparing the elements with eq.
+// As they cannot be hashed, it runs in O(n·m).
+func JaccardSimilarityFunc[T any](a, b ReadSlicer[T], eq func(a, b T) bool) float64 {
+	return jaccard(collect(a), collect(b), eq)
+}
+
+// HammingDistanceFunc returns the number of positions at which a and b differ,
+// comparing the elements with eq.
+// Returns ErrLengthMismatch if their lengths differ.
+func HammingDistanceFunc[T any](a, b ReadSlicer[T], eq func(a, b T) bool) (int, error) {
+	return hamming(collect(a), collect(b), eq)
+}
+
+func lcs[T any](x, y []T, eq func(a, b T) bool) []T {
+	if len(x) == 0 || len(y) == 0 {
+		return nil
+	}
+	if len(x) == 1 {
+		if slices.ContainsFunc(y, func(v T) bool { return eq(x[0], v) }) {
+			return []T{x[0]}
+		}
+		return nil
+	}
+
+	// Split x in half and find where the LCS crosses the middle,
+	// from the lengths of the LCS of each half with the prefixes
+	// and suffixes of y.
+	mid := len(x) / 2
+	upper := lcsLengths(x[:mid], y, eq)
+
+	rx, ry := slices.Clone(x[mid:]), slices.Clone(y)
+	slices.Reverse(rx)
+	slices.Reverse(ry)
+	lower := lcsLengths(rx, ry, eq)
+
+	split, best := 0, -1
+	for j := range len(y) + 1 {
+		if n := upper[j] + lower[len(y)-j]; n > best {
+			split, best = j, n
+		}
+	}
+
+	return append(lcs(x[:mid], y[:split], eq), lcs(x[mid:], y[split:], eq)...)
+}
+
+// lcsLengths returns the lengths of the LCS of x with every prefix of y.
+func lcsLengths[T any](x, y []T, eq func(a, b T) bool) []int {
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for _, a := range x {
+		for j, b := range y {
+			if eq(a, b) {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+func levenshtein[T any](x, y []T, eq func(a, b T) bool) int {
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i, a := range x {
+		cur[0] = i + 1
+		for j, b := range y {
+			cost := 1
+			if eq(a, b) {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j+1]+1, cur[j]+1, prev[j]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(y)]
+}
+
+func damerauLevenshtein[T any](x, y []T, eq func(a, b T) bool) int {
+	// Rows i-2, i-1 and i of the distance matrix.
+	prev2 := make([]int, len(y)+1)
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i, a := range x {
+		cur[0] = i + 1
+		for j, b := range y {
+			cost := 1
+			if eq(a, b) {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j+1]+1, cur[j]+1, prev[j]+cost)
+			if i > 0 && j > 0 && eq(a, y[j-1]) && eq(x[i-1], b) {
+				cur[j+1] = min(cur[j+1], prev2[j-1]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(y)]
+}
+
+func jaccard[T any](x, y []T, eq func(a, b T) bool) float64 {
+	a := slices.Clone(x)
+	removeDuplicatesFunc(&a, eq)
+	b := slices.Clone(y)
+	removeDuplicatesFunc(&b, eq)
+
+	var inter int
+	for _, e := range a {
+		if slices.ContainsFunc(b, func(v T) bool { return eq(e, v) }) {
+			inter++
+		}
+	}
+	return similarity(inter, len(a), len(b))
+}
+
+// removeDuplicatesFunc keeps the first occurrence of every element of s.
+func removeDuplicatesFunc[T any](s *[]T, eq func(a, b T) bool) {
+	unique := uniqueFunc(eq)
+	*s = slices.DeleteFunc(*s, func(v T) bool { return !unique(v) })
+}
+
+// similarity returns the Jaccard similarity of two sets given their sizes
+// and the size of their intersection.
+func similarity(inter, a, b int) float64 {
+	union := a + b - inter
+	if union == 0 {
+		return 1
+	}
+	return float64(inter) / float64(union)
+}
+
+func hamming[T any](x, y []T, eq func(a, b T) bool) (int, error) {
+	if len(x) != len(y) {
+		return 0, fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(x), len(y))
+	}
+
+	var d int
+	for i := range x {
+		if !eq(x[i], y[i]) {
+			d++
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,244 @@
+package slicelib
+
+import (
+	"math"
+	"slices"
+)
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Interpolation selects how Percentile computes a value that falls
+// between two elements, following the modes of NumPy.
+type Interpolation int
+
+const (
+	// Linear interpolates linearly between the two closest elements.
+	Linear Interpolation = iota
+	// Lower takes the lower of the two closest elements.
+	Lower
+	// Higher takes the higher of the two closest elements.
+	Higher
+	// Nearest takes the closest element, the lower one on ties.
+	Nearest
+	// Midpoint takes the mean of the two closest elements.
+	Midpoint
+)
+
+// HistogramBin is a bucket of a histogram holding the elements in [Low, High),
+// or [Low, High] for the last bucket.
+type HistogramBin struct {
+	Low, High float64
+	Count     int
+}
+
+type NumericSlice[T Number] struct {
+	*OrderedSlice[T]
+}
+
+// Creates a new NumericSlice, an OrderedSlice of numbers
+// with statistical methods.
+//
+// Example:
+//
+//	latencies := NewNumericSlice(12.5, 8.1, 30.2)
+//	latencies.Percentile(95, Linear)
+func NewNumericSlice[T Number](slice ...T) *NumericSlice[T] {
+	return &NumericSlice[T]{NewOrderedSlice(slice...)}
+}
+
+// Creates a copy of the current object, which is not the same as the current object.
+func (s NumericSlice[T]) Clone() *NumericSlice[T] {
+	return &NumericSlice[T]{s.OrderedSlice.Clone()}
+}
+
+// sorted returns the elements in ascending order.
+// The underlying slice is returned as is when it is already sorted,
+// otherwise a sorted copy is made, leaving the slice untouched.
+func (s NumericSlice[T]) sorted() []T {
+	if s.IsSorted() {
+		return s.slice
+	}
+	sorted := slices.Clone(s.slice)
+	slices.Sort(sorted)
+	return sorted
+}
+
+// Sum returns the sum of the elements, 0 for an empty slice.
+func (s NumericSlice[T]) Sum() (sum T) {
+	for _, v := range s.slice {
+		sum += v
+	}
+	return
+}
+
+// Product returns the product of the elements, 1 for an empty slice.
+func (s NumericSlice[T]) Product() T {
+	product := T(1)
+	for _, v := range s.slice {
+		product *= v
+	}
+	return product
+}
+
+// Mean returns the arithmetic mean of the elements, NaN for an empty slice.
+// The elements are added as float64, so integer slices cannot overflow.
+func (s NumericSlice[T]) Mean() float64 {
+	if len(s.slice) == 0 {
+		return math.NaN()
+	}
+
+	var sum float64
+	for _, v := range s.slice {
+		sum += float64(v)
+	}
+	return sum / float64(len(s.slice))
+}
+
+// Median returns the middle element, or the mean of the two middle ones,
+// NaN for an empty slice.
+func (s NumericSlice[T]) Median() float64 {
+	return s.Percentile(50, Linear)
+}
+
+// Mode returns the most frequent elements in ascending order,
+// several of them when there is a tie, nil for an empty slice.
+func (s NumericSlice[T]) Mode() []T {
+	var (
+		modes []T
+		best  int
+	)
+	sorted := s.sorted()
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		switch n := j - i; {
+		case n > best:
+			best = n
+			modes = append(modes[:0], sorted[i])
+		case n == best:
+			modes = append(modes, sorted[i])
+		}
+		i = j
+	}
+	return modes
+}
+
+// Percentile returns the p-th percentile of the elements, with p in [0, 100].
+// When it falls between two elements, mode selects how it is computed.
+// Returns NaN for an empty slice.
+//
+// Sorts a copy of the elements unless the slice is already sorted.
+//
+// Panics if p is out of range.
+func (s NumericSlice[T]) Percentile(p float64, mode Interpolation) float64 {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic("slicelib: percentile out of range [0, 100]")
+	}
+	if len(s.slice) == 0 {
+		return math.NaN()
+	}
+
+	sorted := s.sorted()
+	rank := p / 100 * float64(len(sorted)-1)
+	i := int(rank)
+	frac := rank - float64(i)
+	lo := float64(sorted[i])
+	if frac == 0 {
+		return lo
+	}
+	hi := float64(sorted[i+1])
+
+	switch mode {
+	case Lower:
+		return lo
+	case Higher:
+		return hi
+	case Nearest:
+		if frac > 0.5 {
+			return hi
+		}
+		return lo
+	case Midpoint:
+		return (lo + hi) / 2
+	default:
+		return lo + (hi-lo)*frac
+	}
+}
+
+// Variance returns the population variance of the elements, NaN for an empty slice.
+func (s NumericSlice[T]) Variance() float64 {
+	mean := s.Mean()
+
+	var sum float64
+	for _, v := range s.slice {
+		d := float64(v) - mean
+		sum += d * d
+	}
+	return sum / float64(len(s.slice))
+}
+
+// StdDev returns the population standard deviation of the elements, NaN for an empty slice.
+func (s NumericSlice[T]) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// Histogram splits the range between the minimal and the maximal elements
+// into bins buckets of equal width and counts the elements of each one.
+// When all the elements are equal, the range is widened by 0.5 on each side.
+// NaN and infinite elements are not counted.
+// Returns nil if there is no finite element.
+//
+// Panics if bins is not positive.
+func (s NumericSlice[T]) Histogram(bins int) []HistogramBin {
+	if bins <= 0 {
+		panic("slicelib: Histogram needs a positive number of bins")
+	}
+
+	finite := func(v float64) bool { return !math.IsNaN(v) && !math.IsInf(v, 0) }
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, v := range s.slice {
+		if f := float64(v); finite(f) {
+			low, high = min(low, f), max(high, f)
+		}
+	}
+	if low > high {
+		return nil
+	}
+	if low == high {
+		low, high = low-0.5, high+0.5
+	}
+
+	width := (high - low) / float64(bins)
+	histogram := make([]HistogramBin, bins)
+	for i := range histogram {
+		histogram[i].Low = low + float64(i)*width
+		histogram[i].High = low + float64(i+1)*width
+	}
+	histogram[bins-1].High = high
+
+	for _, v := range s.slice {
+		f := float64(v)
+		if !finite(f) {
+			continue
+		}
+		i := max(min(int((f-low)/width), bins-1), 0)
+		histogram[i].Count++
+	}
+	return histogram
+}
@@ -23,7 +23,7 @@ func (s *OrderedSlice[T]) Sort() {
 // Creates a copy of the current object, which is not the same as the current object.
 // implements the slices.Clone function on the internal slice to create the new structure.
 func (s OrderedSlice[T]) Clone() *OrderedSlice[T] {
-	return NewOrderedSlice(slices.Clone(s.slice)...)
+	return &OrderedSlice[T]{s.ComparableSlice.Clone()}
 }
 
 // A shortcut to slices.IsSorted.
@@ -35,3 +35,52 @@ func (s OrderedSlice[T]) IsSorted() bool {
 func (s OrderedSlice[T]) BinarySearch(v T) (int, bool) {
 	return slices.BinarySearch(s.slice, v)
 }
+
+// A shortcut to slices.Min.
+// Panics if the slice is empty.
+func (s OrderedSlice[T]) Min() T {
+	return slices.Min(s.slice)
+}
+
+// A shortcut to slices.Max.
+// Panics if the slice is empty.
+func (s OrderedSlice[T]) Max() T {
+	return slices.Max(s.slice)
+}
+
+// MinMax returns the minimal and the maximal elements in a single pass.
+// Panics if the slice is empty.
+func (s OrderedSlice[T]) MinMax() (lo, hi T) {
+	if len(s.slice) == 0 {
+		panic("slicelib: MinMax of an empty slice")
+	}
+
+	lo, hi = s.slice[0], s.slice[0]
+	for _, v := range s.slice[1:] {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	return
+}
+
+// ArgMin returns the index of the first minimal element, or -1 if the slice is empty.
+func (s *OrderedSlice[T]) ArgMin() int {
+	return ArgMinFunc[T](s, cmp.Compare[T])
+}
+
+// ArgMax returns the index of the first maximal element, or -1 if the slice is empty.
+func (s *OrderedSlice[T]) ArgMax() int {
+	return ArgMaxFunc[T](s, cmp.Compare[T])
+}
+
+// TopK returns the k greatest elements in descending order.
+// See the TopK function.
+func (s *OrderedSlice[T]) TopK(k int) []T {
+	return TopK[T](s, k, cmp.Compare[T])
+}
+
+// BottomK returns the k smallest elements in ascending order.
+// See the BottomK function.
+func (s *OrderedSlice[T]) BottomK(k int) []T {
+	return BottomK[T](s, k, cmp.Compare[T])
+}
@@ -0,0 +1,41 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// positionIndex maps keys to the ascending positions where they are stored.
+// It is shared by IndexedSlice and the indexes of KeyIndexed.
+type positionIndex[K comparable] map[K][]int
+
+// add records that k is at position i.
+func (x positionIndex[K]) add(k K, i int) {
+	p := x[k]
+	at, _ := slices.BinarySearch(p, i)
+	x[k] = slices.Insert(p, at, i)
+}
+
+// drop forgets that k is at position i.
+func (x positionIndex[K]) drop(k K, i int) {
+	p := x[k]
+	at, found := slices.BinarySearch(p, i)
+	if !found {
+		return
+	}
+	if len(p) == 1 {
+		delete(x, k)
+		return
+	}
+	x[k] = slices.Delete(p, at, at+1)
+}
+
+// shift moves every position greater than or equal to from by delta.
+// It visits every key of the index, so it costs O(keys + moved positions).
+func (x positionIndex[K]) shift(from, delta int) {
+	for _, p := range x {
+		at, _ := slices.BinarySearch(p, from)
+		for k := at; k < len(p); k++ {
+			p[k] += delta
+		}
+	}
+}
@@ -0,0 +1,464 @@
+package slicelib
+
+const (
+	pvBits  = 5
+	pvWidth = 1 << pvBits // 32-way branching
+	pvMask  = pvWidth - 1
+)
+
+// pvNode is an internal node (or a leaf) of the PVector trie.
+// Branch nodes use children, leaves use values.
+type pvNode[T any] struct {
+	edit     *pvEdit // Transient allowed to modify the node in place, if any
+	children []*pvNode[T]
+	values   []T
+}
+
+// pvEdit identifies a PVectorTransient.
+// Nodes created by a transient carry its token and can be updated in place
+// until the transient is turned back into a PVector.
+type pvEdit struct {
+	active bool
+}
+
+// PVector is a generic persistent (immutable) vector implemented
+// as a 32-way bit-partitioned trie with a tail buffer.
+//
+// Append, Set and Pop never modify the receiver; they return a new version that
+// shares most of its structure with the old one, so keeping many versions around
+// (undo history, snapshots handed to concurrent readers) is cheap.
+// At runs in O(log32 n), which is effectively constant.
+type PVector[T any] struct {
+	len   int
+	shift uint // Depth of the trie multiplied by pvBits
+	root  *pvNode[T]
+	tail  []T
+}
+
+// NewPVector creates a new PVector with the provided elements.
+//
+// Example:
+//
+//	v1 := NewPVector(1, 2, 3)
+//	v2 := v1.Append(4) // v1 is left untouched
+func NewPVector[T any](slice ...T) *PVector[T] {
+	t := new(PVector[T]).Transient()
+	t.Append(slice...)
+
+	return t.Persistent()
+}
+
+// PVectorFrom creates a new PVector holding the elements of any Ranger,
+// which includes every Slicer and ReadSlicer.
+func PVectorFrom[T any](s Ranger[T]) *PVector[T] {
+	t := new(PVector[T]).Transient()
+	s.Range(func(_ int, v T) bool {
+		t.Append(v)
+		return true
+	})
+
+	return t.Persistent()
+}
+
+// tailOffset returns the index of the first element stored in the tail.
+func (v *PVector[T]) tailOffset() int {
+	if v.len < pvWidth {
+		return 0
+	}
+	return ((v.len - 1) >> pvBits) << pvBits
+}
+
+// leafFor returns the array holding the element at index i.
+func (v *PVector[T]) leafFor(i int) []T {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+
+	n := v.root
+	for level := v.shift; level > 0; level -= pvBits {
+		n = n.children[(i>>level)&pvMask]
+	}
+	return n.values
+}
+
+// Len returns the number of elements in the vector.
+func (v *PVector[T]) Len() int {
+	return v.len
+}
+
+// IsEmpty checks if the vector contains no elements.
+func (v *PVector[T]) IsEmpty() bool {
+	return v.len == 0
+}
+
+// InRange checks if the given index is within the vector's bounds.
+func (v *PVector[T]) InRange(i int) bool {
+	return i >= 0 && i < v.len
+}
+
+// At returns the element at the specified index.
+// Panics if the index is out of bounds.
+func (v *PVector[T]) At(i int) T {
+	if !v.InRange(i) {
+		outOfRangePanic(i, v.len)
+	}
+	return v.leafFor(i)[i&pvMask]
+}
+
+// Range iterates over the elements of the vector, one leaf at a time.
+// The yield function receives the index and value, and can stop iteration by returning false.
+func (v *PVector[T]) Range(f func(int, T) bool) {
+	for i := 0; i < v.len; i += pvWidth {
+		for j, t := range v.leafFor(i) {
+			if !f(i+j, t) {
+				return
+			}
+		}
+	}
+}
+
+// ReverseRange iterates over the elements from the last one to the first one.
+func (v *PVector[T]) ReverseRange(f func(int, T) bool) {
+	for i := v.len - 1; i >= 0; i-- {
+		if !f(i, v.At(i)) {
+			break
+		}
+	}
+}
+
+// Index finds the first occurrence of the specified value in the vector.
+// Uses appropriate comparison strategy based on type comparability.
+// Returns -1 if the value is not found.
+func (v *PVector[T]) Index(val T) (index int) {
+	index = -1
+	eq := equalFunc[T]()
+	v.Range(func(i int, t T) bool {
+		if eq(val, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// LastIndex finds the last occurrence of the specified value in the vector.
+// Returns -1 if the value is not found.
+func (v *PVector[T]) LastIndex(val T) (index int) {
+	index = -1
+	eq := equalFunc[T]()
+	v.ReverseRange(func(i int, t T) bool {
+		if eq(val, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// Contains checks if the vector includes the specified value.
+func (v *PVector[T]) Contains(val T) bool {
+	return v.Index(val) != -1
+}
+
+// Equal compares the vector with a slice for equality.
+// Uses different comparison strategies based on the type's comparability.
+func (v *PVector[T]) Equal(s []T) bool {
+	return v.EqualFunc(s, equalFunc[T]())
+}
+
+// EqualFunc allows custom equality comparison using a provided function.
+func (v *PVector[T]) EqualFunc(s []T, f func(T, T) bool) (eq bool) {
+	if len(s) != v.len {
+		return false
+	}
+
+	eq = true
+	v.Range(func(i int, t T) bool {
+		eq = f(s[i], t)
+		return eq
+	})
+	return
+}
+
+// EqualSlicer compares the vector with another ReadSlicer.
+func (v *PVector[T]) EqualSlicer(s ReadSlicer[T]) bool {
+	return v.EqualSlicerFunc(s, equalFunc[T]())
+}
+
+// EqualSlicerFunc compares the vector with another ReadSlicer using a custom comparison function.
+func (v *PVector[T]) EqualSlicerFunc(s ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(v, s, f)
+}
+
+// S returns a new built-in slice holding the elements of the vector.
+func (v *PVector[T]) S() []T {
+	s := make([]T, 0, v.len)
+	v.Range(func(_ int, t T) bool {
+		s = append(s, t)
+		return true
+	})
+	return s
+}
+
+// Slice converts the vector into a new Slice.
+func (v *PVector[T]) Slice() *Slice[T] {
+	return &Slice[T]{slice: v.S()}
+}
+
+// LinkedList converts the vector into a new LinkedList.
+func (v *PVector[T]) LinkedList() *LinkedList[T] {
+	return NewLinkedList(v.S()...)
+}
+
+// String returns a string representation of the vector.
+func (v *PVector[T]) String() string {
+	return makeString(v.Range, v.Len())
+}
+
+// Append returns a new version of the vector with the elements added at the end.
+func (v *PVector[T]) Append(items ...T) *PVector[T] {
+	if len(items) == 0 {
+		return v
+	}
+
+	t := v.Transient()
+	t.Append(items...)
+	return t.Persistent()
+}
+
+// Set returns a new version of the vector with the element at index i replaced.
+// Only the path from the root to the affected leaf is copied.
+//
+// Panics if the index is out of bounds.
+func (v *PVector[T]) Set(i int, val T) *PVector[T] {
+	t := v.Transient()
+	t.Set(i, val)
+	return t.Persistent()
+}
+
+// Pop returns a new version of the vector without its last element.
+//
+// Panics if the vector is empty.
+func (v *PVector[T]) Pop() *PVector[T] {
+	t := v.Transient()
+	t.Pop()
+	return t.Persistent()
+}
+
+// Transient returns a mutable builder starting from the contents of the vector.
+// The builder updates the nodes it creates in place, which makes batch
+// construction much cheaper than chaining persistent operations.
+// The receiver is never modified.
+func (v *PVector[T]) Transient() *PVectorTransient[T] {
+	t := &PVectorTransient[T]{
+		vec:  *v,
+		edit: &pvEdit{active: true},
+	}
+	t.vec.tail = append(make([]T, 0, pvWidth), v.tail...)
+	if t.vec.root == nil {
+		t.vec.root = &pvNode[T]{}
+		t.vec.shift = pvBits
+	}
+
+	return t
+}
+
+// PVectorTransient is a mutable builder for a PVector.
+// It is obtained with PVector.Transient and turned back into an
+// immutable vector with Persistent, after which it can no longer be used.
+type PVectorTransient[T any] struct {
+	vec  PVector[T]
+	edit *pvEdit
+}
+
+// ensureActive panics if the transient has already been made persistent.
+func (t *PVectorTransient[T]) ensureActive() {
+	if !t.edit.active {
+		panic("slicelib: transient used after Persistent() call")
+	}
+}
+
+// editable returns n itself when the transient owns it, and an owned copy otherwise.
+func (t *PVectorTransient[T]) editable(n *pvNode[T]) *pvNode[T] {
+	if n.edit == t.edit {
+		return n
+	}
+
+	c := &pvNode[T]{edit: t.edit}
+	if n.values != nil {
+		c.values = append(make([]T, 0, pvWidth), n.values...)
+	} else {
+		c.children = append(make([]*pvNode[T], 0, pvWidth), n.children...)
+	}
+	return c
+}
+
+// newPath builds the chain of branch nodes leading to the leaf n.
+func (t *PVectorTransient[T]) newPath(level uint, n *pvNode[T]) *pvNode[T] {
+	if level == 0 {
+		return n
+	}
+	return &pvNode[T]{
+		edit:     t.edit,
+		children: []*pvNode[T]{t.newPath(level-pvBits, n)},
+	}
+}
+
+// pushTail stores the full tail leaf as the rightmost leaf of the trie.
+func (t *PVectorTransient[T]) pushTail(level uint, parent, leaf *pvNode[T]) *pvNode[T] {
+	p := t.editable(parent)
+	sub := ((t.vec.len - 1) >> level) & pvMask
+
+	child := leaf
+	if level > pvBits {
+		if sub < len(p.children) {
+			child = t.pushTail(level-pvBits, p.children[sub], leaf)
+		} else {
+			child = t.newPath(level-pvBits, leaf)
+		}
+	}
+
+	if sub < len(p.children) {
+		p.children[sub] = child
+	} else {
+		p.children = append(p.children, child)
+	}
+	return p
+}
+
+// popTail removes the rightmost leaf of the trie,
+// returning nil when the node becomes empty.
+func (t *PVectorTransient[T]) popTail(level uint, n *pvNode[T]) *pvNode[T] {
+	sub := ((t.vec.len - 2) >> level) & pvMask
+	if level > pvBits {
+		child := t.popTail(level-pvBits, n.children[sub])
+		if child == nil && sub == 0 {
+			return nil
+		}
+
+		p := t.editable(n)
+		if child == nil {
+			p.children = p.children[:sub]
+		} else {
+			p.children[sub] = child
+		}
+		return p
+	}
+
+	if sub == 0 {
+		return nil
+	}
+	p := t.editable(n)
+	p.children = p.children[:sub]
+	return p
+}
+
+// Len returns the number of elements in the transient.
+func (t *PVectorTransient[T]) Len() int {
+	return t.vec.len
+}
+
+// At returns the element at the specified index.
+// Panics if the index is out of bounds.
+func (t *PVectorTransient[T]) At(i int) T {
+	t.ensureActive()
+	return t.vec.At(i)
+}
+
+// Append adds one or more elements to the end of the transient.
+func (t *PVectorTransient[T]) Append(items ...T) {
+	t.ensureActive()
+	v := &t.vec
+	for _, item := range items {
+		if len(v.tail) < pvWidth {
+			v.tail = append(v.tail, item)
+			v.len++
+			continue
+		}
+
+		// The tail is full: move it into the trie, growing a new root on overflow.
+		leaf := &pvNode[T]{edit: t.edit, values: v.tail}
+		if (v.len >> pvBits) > (1 << v.shift) {
+			v.root = &pvNode[T]{
+				edit:     t.edit,
+				children: []*pvNode[T]{v.root, t.newPath(v.shift, leaf)},
+			}
+			v.shift += pvBits
+		} else {
+			v.root = t.pushTail(v.shift, v.root, leaf)
+		}
+
+		v.tail = append(make([]T, 0, pvWidth), item)
+		v.len++
+	}
+}
+
+// Set replaces the element at the specified index.
+// Panics if the index is out of bounds.
+func (t *PVectorTransient[T]) Set(i int, val T) {
+	t.ensureActive()
+	v := &t.vec
+	if !v.InRange(i) {
+		outOfRangePanic(i, v.len)
+	}
+
+	if i >= v.tailOffset() {
+		v.tail[i&pvMask] = val
+		return
+	}
+
+	var set func(level uint, n *pvNode[T]) *pvNode[T]
+	set = func(level uint, n *pvNode[T]) *pvNode[T] {
+		c := t.editable(n)
+		if level == 0 {
+			c.values[i&pvMask] = val
+			return c
+		}
+
+		sub := (i >> level) & pvMask
+		c.children[sub] = set(level-pvBits, n.children[sub])
+		return c
+	}
+	v.root = set(v.shift, v.root)
+}
+
+// Pop removes the last element of the transient.
+// Panics if it is empty.
+func (t *PVectorTransient[T]) Pop() {
+	t.ensureActive()
+	v := &t.vec
+	switch {
+	case v.len == 0:
+		outOfRangePanic(-1, 0)
+	case len(v.tail) > 1 || v.len == 1:
+		v.tail = v.tail[:len(v.tail)-1]
+		v.len--
+		return
+	}
+
+	// The tail becomes empty, so the last leaf of the trie is promoted to be the new tail.
+	v.tail = append(make([]T, 0, pvWidth), v.leafFor(v.len-2)...)
+	root := t.popTail(v.shift, v.root)
+	if root == nil {
+		root = &pvNode[T]{edit: t.edit}
+	}
+	if v.shift > pvBits && len(root.children) == 1 {
+		root = root.children[0]
+		v.shift -= pvBits
+	}
+	v.root = root
+	v.len--
+}
+
+// Persistent returns an immutable PVector holding the contents of the transient.
+// The transient cannot be used afterwards.
+func (t *PVectorTransient[T]) Persistent() *PVector[T] {
+	t.ensureActive()
+	t.edit.active = false
+
+	v := t.vec
+	v.tail = v.tail[:len(v.tail):len(v.tail)]
+	return &v
+}
@@ -0,0 +1,314 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// Query is a lazy pipeline of operations over a sequence, in the style of LINQ.
+// Building a Query does no work and leaves the source untouched: the pipeline
+// runs in a single pass every time the Query is iterated, by Range or by a terminal
+// method such as ToSlice, Count or First, reading the current contents of the source.
+//
+// The methods of Query return new queries, so a Query can be shared and extended
+// in different ways. Operations that change the element type, such as Select,
+// GroupBy and Join, are package functions.
+//
+// Example:
+//
+//	names := Select(
+//		NewQuery[Product](products).
+//			Where(func(p Product) bool { return p.Stock > 0 }).
+//			OrderBy(By(func(p Product) int { return p.Price })).
+//			Take(10),
+//		func(p Product) string { return p.Name },
+//	).ToSlice()
+type Query[T any] struct {
+	seq func(yield func(T) bool)
+
+	// For the queries returned by OrderBy and ThenBy,
+	// the unsorted sequence and the comparators it is sorted by.
+	unsorted func(yield func(T) bool)
+	order    []Comparator[T]
+}
+
+// NewQuery creates a Query over the elements of s, which can be any Slicer
+// or another Ranger such as a PVector or a Query.
+func NewQuery[T any](s Ranger[T]) *Query[T] {
+	return newQuery(func(yield func(T) bool) {
+		s.Range(func(_ int, v T) bool {
+			return yield(v)
+		})
+	})
+}
+
+func newQuery[T any](seq func(yield func(T) bool)) *Query[T] {
+	return &Query[T]{seq: seq}
+}
+
+// Where keeps the elements for which f returns true.
+func (q *Query[T]) Where(f func(T) bool) *Query[T] {
+	return newQuery(func(yield func(T) bool) {
+		q.seq(func(v T) bool {
+			return !f(v) || yield(v)
+		})
+	})
+}
+
+// OrderBy sorts the elements with c. The sort is stable, and ties can be
+// broken with ThenBy.
+// Sorting needs all the elements, so they are buffered when the Query runs.
+func (q *Query[T]) OrderBy(c Comparator[T]) *Query[T] {
+	return q.sorted(q.seq, []Comparator[T]{c})
+}
+
+// ThenBy breaks the ties of the previous OrderBy or ThenBy with c.
+// Panics if the Query is not the result of OrderBy or ThenBy.
+func (q *Query[T]) ThenBy(c Comparator[T]) *Query[T] {
+	if q.order == nil {
+		panic("slicelib: ThenBy must follow OrderBy")
+	}
+	return q.sorted(q.unsorted, append(slices.Clip(q.order), c))
+}
+
+func (q *Query[T]) sorted(unsorted func(yield func(T) bool), order []Comparator[T]) *Query[T] {
+	sorted := newQuery(func(yield func(T) bool) {
+		var items []T
+		unsorted(func(v T) bool {
+			items = append(items, v)
+			return true
+		})
+		slices.SortStableFunc(items, func(a, b T) int {
+			for _, c := range order {
+				if r := c(a, b); r != 0 {
+					return r
+				}
+			}
+			return 0
+		})
+
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+	sorted.unsorted, sorted.order = unsorted, order
+	return sorted
+}
+
+// Skip leaves out the first n elements.
+func (q *Query[T]) Skip(n int) *Query[T] {
+	return newQuery(func(yield func(T) bool) {
+		var skipped int
+		q.seq(func(v T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(v)
+		})
+	})
+}
+
+// Take keeps only the first n elements,
+// stopping the iteration of the source after them.
+func (q *Query[T]) Take(n int) *Query[T] {
+	return newQuery(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		var taken int
+		q.seq(func(v T) bool {
+			taken++
+			return yield(v) && taken < n
+		})
+	})
+}
+
+// Distinct leaves out the elements equal to a previous one.
+// Elements are compared as in the Index method of Slice without a custom equality.
+func (q *Query[T]) Distinct() *Query[T] {
+	return newQuery(func(yield func(T) bool) {
+		unique := uniqueFunc[T](nil)
+		q.seq(func(v T) bool {
+			return !unique(v) || yield(v)
+		})
+	})
+}
+
+// Range runs the Query, calling f with the position and value of every element
+// until it returns false.
+func (q *Query[T]) Range(f func(int, T) bool) {
+	var i int
+	q.seq(func(v T) bool {
+		if !f(i, v) {
+			return false
+		}
+		i++
+		return true
+	})
+}
+
+// ReverseRange runs the Query and calls f with the elements from last to first,
+// until it returns false. The elements are buffered to reverse them.
+func (q *Query[T]) ReverseRange(f func(int, T) bool) {
+	items := q.items()
+	for i := len(items) - 1; i >= 0; i-- {
+		if !f(i, items[i]) {
+			return
+		}
+	}
+}
+
+// items runs the Query and returns its elements.
+func (q *Query[T]) items() (items []T) {
+	q.seq(func(v T) bool {
+		items = append(items, v)
+		return true
+	})
+	return
+}
+
+// Any reports whether f returns true for any element,
+// stopping at the first one.
+func (q *Query[T]) Any(f func(T) bool) (found bool) {
+	q.seq(func(v T) bool {
+		found = f(v)
+		return !found
+	})
+	return
+}
+
+// All reports whether f returns true for every element,
+// stopping at the first one for which it does not. It is true for an empty Query.
+func (q *Query[T]) All(f func(T) bool) bool {
+	return !q.Any(func(v T) bool { return !f(v) })
+}
+
+// First returns the first element, stopping the Query there.
+// ok is false if the Query is empty.
+func (q *Query[T]) First() (v T, ok bool) {
+	q.seq(func(e T) bool {
+		v, ok = e, true
+		return false
+	})
+	return
+}
+
+// Last returns the last element.
+// ok is false if the Query is empty.
+func (q *Query[T]) Last() (v T, ok bool) {
+	q.seq(func(e T) bool {
+		v, ok = e, true
+		return true
+	})
+	return
+}
+
+// Count returns the number of elements.
+func (q *Query[T]) Count() (n int) {
+	q.seq(func(T) bool {
+		n++
+		return true
+	})
+	return
+}
+
+// ToSlice runs the Query and returns its elements in a new Slice.
+func (q *Query[T]) ToSlice() *Slice[T] {
+	return &Slice[T]{slice: q.items()}
+}
+
+// ToLinkedList runs the Query and returns its elements in a new LinkedList.
+func (q *Query[T]) ToLinkedList() *LinkedList[T] {
+	ll := NewLinkedList[T]()
+	q.seq(func(v T) bool {
+		ll.Append(v)
+		return true
+	})
+	return ll
+}
+
+// Select projects every element of q with f.
+func Select[T, U any](q *Query[T], f func(T) U) *Query[U] {
+	return newQuery(func(yield func(U) bool) {
+		q.seq(func(v T) bool {
+			return yield(f(v))
+		})
+	})
+}
+
+// Group is a key with the elements that have it, in their original order.
+type Group[K comparable, T any] struct {
+	Key    K
+	Values []T
+}
+
+// GroupBy groups the elements of q by the key returned by key,
+// yielding the groups in the order their keys first appear.
+// Grouping needs all the elements, so they are buffered when the Query runs.
+//
+// Example:
+//
+//	GroupBy(NewQuery[Order](orders), func(o Order) string { return o.Status })
+func GroupBy[T any, K comparable](q *Query[T], key func(T) K) *Query[Group[K, T]] {
+	return newQuery(func(yield func(Group[K, T]) bool) {
+		var groups []Group[K, T]
+		positions := make(map[K]int)
+		q.seq(func(v T) bool {
+			k := key(v)
+			i, ok := positions[k]
+			if !ok {
+				i = len(groups)
+				positions[k] = i
+				groups = append(groups, Group[K, T]{Key: k})
+			}
+			groups[i].Values = append(groups[i].Values, v)
+			return true
+		})
+
+		for _, g := range groups {
+			if !yield(g) {
+				return
+			}
+		}
+	})
+}
+
+// Join pairs every element of outer with every element of inner that has the
+// same key, yielding the results of calling result on every pair, like an inner join.
+// The results follow the order of outer, then the order of inner.
+// inner is read once per run of the Query, into a hash table.
+//
+// Example:
+//
+//	Join(NewQuery[Order](orders), NewQuery[Customer](customers),
+//		func(o Order) int { return o.CustomerID },
+//		func(c Customer) int { return c.ID },
+//		func(o Order, c Customer) string { return c.Name + ": " + o.Item },
+//	)
+func Join[T, U any, K comparable, R any](
+	outer *Query[T],
+	inner *Query[U],
+	outerKey func(T) K,
+	innerKey func(U) K,
+	result func(T, U) R,
+) *Query[R] {
+	return newQuery(func(yield func(R) bool) {
+		table := make(map[K][]U)
+		inner.seq(func(u U) bool {
+			k := innerKey(u)
+			table[k] = append(table[k], u)
+			return true
+		})
+
+		outer.seq(func(t T) bool {
+			for _, u := range table[outerKey(t)] {
+				if !yield(result(t, u)) {
+					return false
+				}
+			}
+			return true
+		})
+	})
+}
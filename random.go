@@ -0,0 +1,147 @@
+package slicelib
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// ErrInvalidWeights is returned by WeightedChoice when a weight is negative,
+// infinite or NaN, or when all of them are zero.
+var ErrInvalidWeights = errors.New("slicelib: invalid weights")
+
+// The functions of this file take the source of randomness as a *rand.Rand,
+// so that seeded sources give reproducible results.
+// A nil *rand.Rand uses the global source of math/rand/v2.
+
+// intN returns a random int in [0, n) from r, or from the global source if r is nil.
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+// float64N returns a random float64 in [0, 1) from r, or from the global source if r is nil.
+func float64N(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// shuffle shuffles items in place with the Fisher-Yates algorithm.
+func shuffle[T any](items []T, r *rand.Rand) {
+	for i := len(items) - 1; i > 0; i-- {
+		j := intN(r, i+1)
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+// Shuffle randomly reorders the elements of s, every permutation being equally likely.
+//
+// Example:
+//
+//	r := rand.New(rand.NewPCG(1, 2))
+//	Shuffle[int](deck, r) // Same order on every run
+func Shuffle[T any](s Slicer[T], r *rand.Rand) {
+	if sl, ok := s.(*Slice[T]); ok {
+		shuffle(sl.slice, r)
+		return
+	}
+
+	items := collect[T](s)
+	shuffle(items, r)
+	s.Clear()
+	s.Append(items...)
+}
+
+// Sample returns k elements of s chosen at random without replacement, in random order.
+// If s has fewer than k elements, all of them are returned.
+func Sample[T any](s ReadSlicer[T], k int, r *rand.Rand) []T {
+	items := collect(s)
+	k = max(min(k, len(items)), 0)
+
+	// A partial Fisher-Yates shuffle of the first k positions.
+	for i := range k {
+		j := i + intN(r, len(items)-i)
+		items[i], items[j] = items[j], items[i]
+	}
+	return items[:k:k]
+}
+
+// Choice returns an element of s chosen at random.
+// Panics if s is empty.
+func Choice[T any](s Indexer[T], r *rand.Rand) T {
+	if s.IsEmpty() {
+		panic("slicelib: Choice from an empty sequence")
+	}
+	return s.At(intN(r, s.Len()))
+}
+
+// WeightedChoice returns an element of s chosen at random,
+// the i-th one with a probability proportional to weights[i].
+//
+// Returns ErrLengthMismatch if there is not one weight per element,
+// and ErrInvalidWeights if a weight is negative, infinite or NaN, or if all of them are zero.
+func WeightedChoice[T any](s Indexer[T], weights []float64, r *rand.Rand) (v T, err error) {
+	if len(weights) != s.Len() {
+		err = fmt.Errorf("%w: %d elements and %d weights", ErrLengthMismatch, s.Len(), len(weights))
+		return
+	}
+
+	var total float64
+	for _, w := range weights {
+		if w < 0 || math.IsInf(w, 0) || math.IsNaN(w) {
+			err = fmt.Errorf("%w: %v", ErrInvalidWeights, w)
+			return
+		}
+		total += w
+	}
+	if total == 0 {
+		err = fmt.Errorf("%w: all weights are zero", ErrInvalidWeights)
+		return
+	}
+
+	target := float64N(r) * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		last = i
+		if target < w {
+			return s.At(i), nil
+		}
+		target -= w
+	}
+	// Rounding errors can leave a small remainder.
+	return s.At(last), nil
+}
+
+// ReservoirSample returns k elements chosen at random without replacement
+// from s, iterating over it only once and keeping only k elements in memory.
+// It is suited to long LinkedLists and other sequences whose length is
+// unknown or costly to compute.
+// If s has fewer than k elements, all of them are returned.
+//
+// The order of the result is not random; Shuffle it if needed.
+func ReservoirSample[T any](s Ranger[T], k int, r *rand.Rand) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	reservoir := make([]T, 0, k)
+	var seen int
+	s.Range(func(_ int, v T) bool {
+		if seen < k {
+			reservoir = append(reservoir, v)
+		} else if j := intN(r, seen+1); j < k {
+			reservoir[j] = v
+		}
+		seen++
+		return true
+	})
+	return reservoir
+}
@@ -0,0 +1,191 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// readOnly wraps a ReadSlicer hiding every mutating method of the underlying value.
+type readOnly[T any] struct {
+	s ReadSlicer[T]
+}
+
+// ReadOnly returns a read-only view of s.
+// Reads go through to s, so later changes made by the owner are visible,
+// but the view cannot be used (or type-asserted) to mutate it.
+// S returns a defensive copy of the elements.
+//
+// Example:
+//
+//	s := NewSlice(1, 2, 3)
+//	plugin.Run(ReadOnly[int](s))
+func ReadOnly[T any](s ReadSlicer[T]) ReadSlicer[T] {
+	if ro, ok := s.(readOnly[T]); ok {
+		return ro
+	}
+	return readOnly[T]{s}
+}
+
+func (r readOnly[T]) At(i int) T {
+	return r.s.At(i)
+}
+
+func (r readOnly[T]) S() []T {
+	return slices.Clone(r.s.S())
+}
+
+func (r readOnly[T]) Len() int {
+	return r.s.Len()
+}
+
+func (r readOnly[T]) String() string {
+	return r.s.String()
+}
+
+func (r readOnly[T]) Range(f func(int, T) bool) {
+	r.s.Range(f)
+}
+
+func (r readOnly[T]) ReverseRange(f func(int, T) bool) {
+	r.s.ReverseRange(f)
+}
+
+func (r readOnly[T]) Index(v T) int {
+	return r.s.Index(v)
+}
+
+func (r readOnly[T]) LastIndex(v T) int {
+	return r.s.LastIndex(v)
+}
+
+func (r readOnly[T]) Contains(v T) bool {
+	return r.s.Contains(v)
+}
+
+func (r readOnly[T]) IsEmpty() bool {
+	return r.s.IsEmpty()
+}
+
+func (r readOnly[T]) InRange(i int) bool {
+	return r.s.InRange(i)
+}
+
+func (r readOnly[T]) Equal(v []T) bool {
+	return r.s.Equal(v)
+}
+
+func (r readOnly[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return r.s.EqualSlicer(v)
+}
+
+func (r readOnly[T]) EqualFunc(v []T, f func(T, T) bool) bool {
+	return r.s.EqualFunc(v, f)
+}
+
+func (r readOnly[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
+	return r.s.EqualSlicerFunc(v, f)
+}
+
+// ImmutableSlice is a Slice that cannot be modified after its creation.
+// It only implements ReadSlicer, and S returns a defensive copy,
+// so it can be safely handed to untrusted code.
+type ImmutableSlice[T any] struct {
+	s Slice[T]
+}
+
+// NewImmutableSlice creates a new ImmutableSlice holding a copy of the provided elements.
+//
+// Example:
+//
+//	days := NewImmutableSlice("mon", "tue", "wed")
+func NewImmutableSlice[T any](slice ...T) *ImmutableSlice[T] {
+	return &ImmutableSlice[T]{Slice[T]{slice: slices.Clone(slice)}}
+}
+
+// sliceP gives read-only access to the storage for internal helpers.
+func (s *ImmutableSlice[T]) sliceP() *[]T {
+	return &s.s.slice
+}
+
+// At returns the element at the specified index.
+// Panics if the index is out of bounds.
+func (s *ImmutableSlice[T]) At(i int) T {
+	return s.s.At(i)
+}
+
+// S returns a copy of the underlying slice.
+// Modifying it does not affect the ImmutableSlice.
+func (s *ImmutableSlice[T]) S() []T {
+	return s.s.CloneS()
+}
+
+// Slice returns a mutable copy of the ImmutableSlice.
+func (s *ImmutableSlice[T]) Slice() *Slice[T] {
+	return s.s.Clone()
+}
+
+// Len returns the number of elements in the slice.
+func (s *ImmutableSlice[T]) Len() int {
+	return s.s.Len()
+}
+
+// String returns a string representation of the slice.
+func (s *ImmutableSlice[T]) String() string {
+	return s.s.String()
+}
+
+// Range provides an iterator-like functionality for the slice.
+func (s *ImmutableSlice[T]) Range(f func(int, T) bool) {
+	s.s.Range(f)
+}
+
+// ReverseRange iterates over the slice from the last element to the first.
+func (s *ImmutableSlice[T]) ReverseRange(f func(int, T) bool) {
+	s.s.ReverseRange(f)
+}
+
+// Index finds the first occurrence of the specified value in the slice.
+// Returns -1 if not found.
+func (s *ImmutableSlice[T]) Index(v T) int {
+	return s.s.Index(v)
+}
+
+// LastIndex finds the last occurrence of the specified value in the slice.
+// Returns -1 if not found.
+func (s *ImmutableSlice[T]) LastIndex(v T) int {
+	return s.s.LastIndex(v)
+}
+
+// Contains checks if the slice includes the specified value.
+func (s *ImmutableSlice[T]) Contains(v T) bool {
+	return s.s.Contains(v)
+}
+
+// IsEmpty checks if the slice contains no elements.
+func (s *ImmutableSlice[T]) IsEmpty() bool {
+	return s.s.IsEmpty()
+}
+
+// InRange checks if the given index is within the slice's bounds.
+func (s *ImmutableSlice[T]) InRange(i int) bool {
+	return s.s.InRange(i)
+}
+
+// Equal compares the slice with another slice for equality.
+func (s *ImmutableSlice[T]) Equal(v []T) bool {
+	return s.s.Equal(v)
+}
+
+// EqualFunc allows custom equality comparison using a provided function.
+func (s *ImmutableSlice[T]) EqualFunc(v []T, f func(T, T) bool) bool {
+	return s.s.EqualFunc(v, f)
+}
+
+// EqualSlicer compares the slice with another ReadSlicer.
+func (s *ImmutableSlice[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return s.EqualSlicerFunc(v, equalFunc[T]())
+}
+
+// EqualSlicerFunc compares the slice with another ReadSlicer using a custom comparison function.
+func (s *ImmutableSlice[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(s, v, f)
+}
@@ -0,0 +1,171 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// kmpTable returns the failure function of sub for the Knuth-Morris-Pratt algorithm:
+// table[i] is the length of the longest proper prefix of sub[:i+1] that is also its suffix.
+func kmpTable[T any](sub []T, eq func(a, b T) bool) []int {
+	table := make([]int, len(sub))
+	for i, k := 1, 0; i < len(sub); i++ {
+		for k > 0 && !eq(sub[i], sub[k]) {
+			k = table[k-1]
+		}
+		if eq(sub[i], sub[k]) {
+			k++
+		}
+		table[i] = k
+	}
+	return table
+}
+
+// kmpSearch calls found with the position of every non-overlapping occurrence
+// of the non-empty sub in s, from left to right, until it returns false.
+func kmpSearch[T any](s, sub []T, eq func(a, b T) bool, found func(int) bool) {
+	table := kmpTable(sub, eq)
+	for i, k := 0, 0; i < len(s); i++ {
+		for k > 0 && !eq(s[i], sub[k]) {
+			k = table[k-1]
+		}
+		if eq(s[i], sub[k]) {
+			k++
+		}
+		if k == len(sub) {
+			if !found(i - k + 1) {
+				return
+			}
+			k = 0
+		}
+	}
+}
+
+// indexSeq returns the positions of the non-overlapping occurrences of sub in s,
+// at most n of them if n >= 0.
+func indexSeq[T any](s, sub []T, eq func(a, b T) bool, n int) (positions []int) {
+	if len(sub) == 0 {
+		for i := 0; i <= len(s) && (n < 0 || i < n); i++ {
+			positions = append(positions, i)
+		}
+		return
+	}
+
+	kmpSearch(s, sub, eq, func(i int) bool {
+		positions = append(positions, i)
+		return n < 0 || len(positions) < n
+	})
+	return
+}
+
+// IndexSeq returns the position of the first occurrence of sub in the slice, or -1 if not found.
+// An empty sub is found at position 0.
+// Uses the Knuth-Morris-Pratt algorithm, running in O(n+m),
+// and the same comparison strategy as Index.
+func (s ComparableSlice[T]) IndexSeq(sub []T) int {
+	if p := indexSeq(s.slice, sub, s.equal(), 1); len(p) > 0 {
+		return p[0]
+	}
+	return -1
+}
+
+// LastIndexSeq returns the position of the last occurrence of sub in the slice, or -1 if not found.
+// An empty sub is found at the end of the slice.
+func (s ComparableSlice[T]) LastIndexSeq(sub []T) int {
+	if len(sub) == 0 {
+		return len(s.slice)
+	}
+
+	// Search the reversed sub in the reversed slice.
+	rs, rsub := slices.Clone(s.slice), slices.Clone(sub)
+	slices.Reverse(rs)
+	slices.Reverse(rsub)
+	if p := indexSeq(rs, rsub, s.equal(), 1); len(p) > 0 {
+		return len(s.slice) - p[0] - len(sub)
+	}
+	return -1
+}
+
+// CountSeq returns the number of non-overlapping occurrences of sub in the slice.
+// An empty sub is counted once before and after every element, as bytes.Count does.
+func (s ComparableSlice[T]) CountSeq(sub []T) int {
+	return len(indexSeq(s.slice, sub, s.equal(), -1))
+}
+
+// Split slices the elements into the subslices separated by sep,
+// returning new slices that do not share storage with the receiver.
+// An empty sep splits after every element, as bytes.Split does.
+//
+// Example:
+//
+//	NewComparableSlice(1, 0, 2, 3, 0, 4).Split([]int{0})
+//	// [[1] [2 3] [4]]
+func (s ComparableSlice[T]) Split(sep []T) []*ComparableSlice[T] {
+	var parts []*ComparableSlice[T]
+	if len(sep) == 0 {
+		for _, v := range s.slice {
+			parts = append(parts, s.withItems([]T{v}))
+		}
+		return parts
+	}
+
+	start := 0
+	for _, i := range indexSeq(s.slice, sep, s.equal(), -1) {
+		parts = append(parts, s.withItems(slices.Clone(s.slice[start:i])))
+		start = i + len(sep)
+	}
+	return append(parts, s.withItems(slices.Clone(s.slice[start:])))
+}
+
+// withItems returns a ComparableSlice of items with the same equality strategy as s.
+func (s ComparableSlice[T]) withItems(items []T) *ComparableSlice[T] {
+	return &ComparableSlice[T]{&Slice[T]{slice: items, eq: s.eq}}
+}
+
+// ReplaceAll replaces every non-overlapping occurrence of old with repl.
+// An empty old matches before and after every element, as in bytes.ReplaceAll.
+func (s *ComparableSlice[T]) ReplaceAll(old, repl []T) {
+	positions := indexSeq(s.slice, old, s.equal(), -1)
+	if len(positions) == 0 {
+		return
+	}
+
+	replaced := make([]T, 0, len(s.slice)+len(positions)*(len(repl)-len(old)))
+	start := 0
+	for _, i := range positions {
+		replaced = append(replaced, s.slice[start:i]...)
+		replaced = append(replaced, repl...)
+		start = i + len(old)
+		if len(old) == 0 && i < len(s.slice) {
+			// Keep the element the empty match is in front of.
+			replaced = append(replaced, s.slice[i])
+			start++
+		}
+	}
+	s.slice = append(replaced, s.slice[start:]...)
+}
+
+// HasPrefix reports whether the slice begins with prefix.
+func (s ComparableSlice[T]) HasPrefix(prefix []T) bool {
+	return len(prefix) <= len(s.slice) &&
+		slices.EqualFunc(s.slice[:len(prefix)], prefix, s.equal())
+}
+
+// HasSuffix reports whether the slice ends with suffix.
+func (s ComparableSlice[T]) HasSuffix(suffix []T) bool {
+	return len(suffix) <= len(s.slice) &&
+		slices.EqualFunc(s.slice[len(s.slice)-len(suffix):], suffix, s.equal())
+}
+
+// TrimPrefix removes prefix from the beginning of the slice, if present.
+func (s *ComparableSlice[T]) TrimPrefix(prefix []T) {
+	if s.HasPrefix(prefix) {
+		s.slice = s.slice[len(prefix):]
+	}
+}
+
+// TrimSuffix removes suffix from the end of the slice, if present.
+func (s *ComparableSlice[T]) TrimSuffix(suffix []T) {
+	if s.HasSuffix(suffix) {
+		s.slice = s.slice[:len(s.slice)-len(suffix)]
+	}
+}
@@ -1,7 +1,6 @@
 package slicelib
 
 import (
-	"reflect"
 	"slices"
 )
 
@@ -9,6 +8,7 @@ import (
 // It allows for more flexible slice operations with generic type support.
 type Slice[T any] struct {
 	slice []T
+	eq    EqualityStrategy[T] // Custom equality, nil for the default one
 }
 
 // NewSlice creates a new Slice instance with the provided elements.
@@ -19,7 +19,7 @@ type Slice[T any] struct {
 //	intSlice := NewSlice(1, 2, 3)
 //	stringSlice := NewSlice("a", "b", "c")
 func NewSlice[T any](slice ...T) *Slice[T] {
-	return &Slice[T]{slices.Clone(slice)}
+	return &Slice[T]{slice: slices.Clone(slice)}
 }
 
 // Elem returns the element at the specified index.
@@ -48,6 +48,13 @@ func (s *Slice[T]) SliceP() *[]T {
 	return &s.slice
 }
 
+// sliceP is the internal counterpart of SliceP.
+// It is used by read-only helpers, so wrappers can expose their storage
+// without the side effects of their SliceP.
+func (s *Slice[T]) sliceP() *[]T {
+	return &s.slice
+}
+
 // Append adds one or more elements to the end of the slice.
 // Equivalent to using the built-in append function.
 func (s *Slice[T]) Append(items ...T) {
@@ -73,9 +80,9 @@ func (s *Slice[T]) Clear() {
 // Clone creates a deep copy of the current Slice.
 // Uses slices.Clone to create a new slice with the same elements.
 //
-// Returns a new Slice instance with copied elements.
+// Returns a new Slice instance with copied elements and the same equality strategy.
 func (s Slice[T]) Clone() *Slice[T] {
-	return NewSlice(slices.Clone(s.slice)...)
+	return &Slice[T]{slice: slices.Clone(s.slice), eq: s.eq}
 }
 
 // CloneS returns a clone of the underlying slice.
@@ -84,39 +91,60 @@ func (s Slice[T]) CloneS() []T {
 	return slices.Clone(s.slice)
 }
 
+// NewSliceWithEquality creates a new Slice that compares its elements with eq.
+//
+// Example:
+//
+//	s := NewSliceWithEquality(strings.EqualFold, "Go", "go", "GO")
+//	s.RemoveDuplicates() // [Go]
+func NewSliceWithEquality[T any](eq EqualityStrategy[T], slice ...T) *Slice[T] {
+	s := NewSlice(slice...)
+	s.SetEquality(eq)
+
+	return s
+}
+
+// SetEquality sets the strategy used to compare elements in Index, LastIndex,
+// Contains, Remove, RemoveLast, RemoveDuplicates and Equal.
+// A nil strategy restores the default comparison.
+func (s *Slice[T]) SetEquality(eq EqualityStrategy[T]) {
+	s.eq = eq
+}
+
+// equality returns the EqualityStrategy set on the Slice, if any.
+func (s Slice[T]) equality() EqualityStrategy[T] {
+	return s.eq
+}
+
+// equal returns the comparison strategy of the slice.
+func (s Slice[T]) equal() func(T, T) bool {
+	return strategyOr(s.eq)
+}
+
 // Index finds the first occurrence of the specified value in the slice.
-// Uses different comparison strategies based on the type's comparability:
+// Uses the strategy set with SetEquality if any, otherwise:
+// - For types implementing Equaler, uses their Equal method
 // - For comparable types, uses standard equality
 // - For non-comparable types, uses deep reflection comparison
 //
 // Returns the index of the first matching element, or -1 if not found.
 func (s Slice[T]) Index(v T) int {
-	if reflect.TypeFor[T]().Comparable() {
-		return slices.IndexFunc(s.slice, comparableEqual(v))
-	}
-	return slices.IndexFunc(s.slice, deepEqual(v))
+	eq := s.equal()
+	return slices.IndexFunc(s.slice, func(t T) bool {
+		return eq(v, t)
+	})
 }
 
+// LastIndex finds the last occurrence of the specified value in the slice.
+// Returns -1 if not found.
 func (s *Slice[T]) LastIndex(val T) int {
-	i := -1
-	if reflect.TypeFor[T]().Comparable() {
-		s.ReverseRange(func(ii int, t T) bool {
-			found := any(t) == any(val)
-			if found {
-				i = ii
-			}
-			return !found
-		})
-	} else {
-		s.Range(func(k int, v T) bool {
-			found := reflect.DeepEqual(val, v)
-			if found {
-				i = k
-			}
-			return !found
-		})
+	eq := s.equal()
+	for i := len(s.slice) - 1; i >= 0; i-- {
+		if eq(val, s.slice[i]) {
+			return i
+		}
 	}
-	return i
+	return -1
 }
 
 // Insert adds one or more elements at the specified index.
@@ -166,38 +194,35 @@ func (s Slice[T]) Len() int {
 }
 
 // Contains checks if the slice includes the specified value.
-// Uses different comparison strategies based on the type's comparability.
+// Uses the same comparison strategy as Index.
 func (s Slice[T]) Contains(v T) bool {
-	if reflect.TypeFor[T]().Comparable() {
-		return slices.ContainsFunc(s.slice, comparableEqual(v))
-	}
-	return slices.ContainsFunc(s.slice, deepEqual(v))
+	return s.Index(v) != -1
 }
 
 // RemoveDuplicates eliminates duplicate elements, keeping only unique values.
 // Preserves the order of first occurrences.
+// Uses the same comparison strategy as Index: comparable elements are hashed,
+// while the others (slices, maps, structs holding them...) are compared pairwise.
+//
+// See RemoveDuplicatesFunc and RemoveDuplicatesEqual for more control.
 func (s *Slice[T]) RemoveDuplicates() {
-	seen := make(map[any]bool)
+	unique := uniqueFunc(s.eq)
 	var j int
 	for i, v := range s.slice {
-		if !seen[v] {
-			seen[v] = true
+		if unique(v) {
 			s.slice[j] = s.slice[i]
 			j++
 		}
 	}
 
+	clear(s.slice[j:])
 	s.slice = s.slice[:j]
 }
 
 // Equal compares the slice with another slice for equality.
-// Uses different comparison strategies based on the type's comparability.
+// Uses the same comparison strategy as Index.
 func (s Slice[T]) Equal(v []T) bool {
-	if reflect.TypeFor[T]().Comparable() {
-		return slices.EqualFunc(s.slice, v, comparableEqual2[T])
-	}
-
-	return slices.EqualFunc(s.slice, v, deepEqual2[T])
+	return slices.EqualFunc(s.slice, v, s.equal())
 }
 
 // EqualSlice compares the current slice with another Slice instance.
@@ -220,6 +245,12 @@ func (s *Slice[T]) SortFunc(f func(a, b T) int) {
 	slices.SortFunc(s.slice, f)
 }
 
+// SortStableFunc sorts the slice with a comparison function,
+// keeping the original order of equal elements.
+func (s *Slice[T]) SortStableFunc(f func(a, b T) int) {
+	slices.SortStableFunc(s.slice, f)
+}
+
 // Filter removes elements that do not match the provided predicate function.
 // Keeps only elements for which the function returns true.
 func (s *Slice[T]) Filter(f func(T) (pass bool)) {
@@ -271,17 +302,14 @@ func (s *Slice[T]) Clip() {
 }
 
 // EqualSlicerFunc compares the current slice with another Slicer using a custom comparison function.
-func (s *Slice[T]) EqualSlicerFunc(v Slicer[T], f func(T, T) bool) bool {
+func (s *Slice[T]) EqualSlicerFunc(v ReadSlicer[T], f func(T, T) bool) bool {
 	return equalSlicersFunc(s, v, f)
 }
 
 // EqualSlicer compares the current slice with another Slicer.
-// Uses appropriate comparison strategy based on the type's comparability.
-func (s *Slice[T]) EqualSlicer(v Slicer[T]) bool {
-	if reflect.TypeFor[T]().Comparable() {
-		return s.EqualSlicerFunc(v, comparableEqual2[T])
-	}
-	return s.EqualSlicerFunc(v, deepEqual2[T])
+// Uses the same comparison strategy as Index.
+func (s *Slice[T]) EqualSlicer(v ReadSlicer[T]) bool {
+	return s.EqualSlicerFunc(v, s.equal())
 }
 
 // Is equal to slice[:x]
@@ -302,3 +330,8 @@ func (s *Slice[T]) SliceRange(i, j int) {
 func (s *Slice[T]) InRange(i int) bool {
 	return i >= 0 && i < len(s.slice)
 }
+
+// View returns a live window onto the elements [i:j]; see the view type.
+func (s *Slice[T]) View(i, j int) Slicer[T] {
+	return newView[T](s, i, j)
+}
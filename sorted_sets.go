@@ -0,0 +1,252 @@
+package slicelib
+
+import (
+	"cmp"
+	"container/heap"
+	"errors"
+	"slices"
+)
+
+// ErrNotSorted is returned by the sorted set operations, when the package is
+// built with the slicelib_debug tag, if one of their inputs is not sorted.
+var ErrNotSorted = errors.New("slicelib: input is not sorted")
+
+// SetMode selects how the sorted set operations treat repeated elements.
+type SetMode int
+
+const (
+	// Multiset keeps repeated elements: an element appearing n times in a and
+	// m times in b appears n+m times in a merge, max(n, m) times in a union,
+	// min(n, m) times in an intersection and n-m times in a difference.
+	Multiset SetMode = iota
+	// Distinct treats the inputs as sets: every element appears at most once in the result.
+	Distinct
+)
+
+// sortedInput returns the elements of s in ascending order.
+// Unsorted inputs are an error in debug builds, and are otherwise sorted
+// into a copy, leaving s untouched.
+// With Distinct, repeated elements are removed.
+func sortedInput[T cmp.Ordered](s *OrderedSlice[T], mode SetMode) ([]T, error) {
+	in := s.slice
+	if !s.IsSorted() {
+		if debug {
+			return nil, ErrNotSorted
+		}
+		in = slices.Clone(in)
+		slices.Sort(in)
+	}
+
+	if mode == Distinct {
+		in = compactCopy(in)
+	}
+	return in, nil
+}
+
+// compactCopy returns the sorted slice s without repeated elements, without modifying it.
+func compactCopy[T comparable](s []T) []T {
+	out := make([]T, 0, len(s))
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// sortedPair returns the sorted inputs of a binary set operation.
+func sortedPair[T cmp.Ordered](a, b *OrderedSlice[T], mode SetMode) (x, y []T, err error) {
+	if x, err = sortedInput(a, mode); err != nil {
+		return
+	}
+	y, err = sortedInput(b, mode)
+	return
+}
+
+// fromSorted wraps the result of a set operation.
+func fromSorted[T cmp.Ordered](out []T) *OrderedSlice[T] {
+	return &OrderedSlice[T]{&ComparableSlice[T]{&Slice[T]{slice: out}}}
+}
+
+// MergeSorted merges the sorted slices a and b into a new sorted slice in O(n+m).
+// With Multiset every element of both inputs is kept, with Distinct each one appears once.
+//
+// If an input is not sorted, a sorted copy of it is used instead,
+// or ErrNotSorted is returned when built with the slicelib_debug tag.
+func MergeSorted[T cmp.Ordered](a, b *OrderedSlice[T], mode SetMode) (*OrderedSlice[T], error) {
+	x, y, err := sortedPair(a, b, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, 0, len(x)+len(y))
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] < y[j]:
+			out = append(out, x[i])
+			i++
+		case y[j] < x[i]:
+			out = append(out, y[j])
+			j++
+		case mode == Distinct:
+			out = append(out, x[i])
+			i++
+			j++
+		default:
+			out = append(out, x[i], y[j])
+			i++
+			j++
+		}
+	}
+	out = append(out, x[i:]...)
+	out = append(out, y[j:]...)
+	return fromSorted(out), nil
+}
+
+// UnionSorted returns the union of the sorted slices a and b in O(n+m).
+// See SetMode for the treatment of repeated elements.
+//
+// If an input is not sorted, a sorted copy of it is used instead,
+// or ErrNotSorted is returned when built with the slicelib_debug tag.
+func UnionSorted[T cmp.Ordered](a, b *OrderedSlice[T], mode SetMode) (*OrderedSlice[T], error) {
+	x, y, err := sortedPair(a, b, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, 0, max(len(x), len(y)))
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] < y[j]:
+			out = append(out, x[i])
+			i++
+		case y[j] < x[i]:
+			out = append(out, y[j])
+			j++
+		default:
+			out = append(out, x[i])
+			i++
+			j++
+		}
+	}
+	out = append(out, x[i:]...)
+	out = append(out, y[j:]...)
+	return fromSorted(out), nil
+}
+
+// IntersectSorted returns the elements of the sorted slices a and b present in both, in O(n+m).
+// See SetMode for the treatment of repeated elements.
+//
+// If an input is not sorted, a sorted copy of it is used instead,
+// or ErrNotSorted is returned when built with the slicelib_debug tag.
+func IntersectSorted[T cmp.Ordered](a, b *OrderedSlice[T], mode SetMode) (*OrderedSlice[T], error) {
+	x, y, err := sortedPair(a, b, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []T
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] < y[j]:
+			i++
+		case y[j] < x[i]:
+			j++
+		default:
+			out = append(out, x[i])
+			i++
+			j++
+		}
+	}
+	return fromSorted(out), nil
+}
+
+// DifferenceSorted returns the elements of the sorted slice a that are not in b, in O(n+m).
+// See SetMode for the treatment of repeated elements.
+//
+// If an input is not sorted, a sorted copy of it is used instead,
+// or ErrNotSorted is returned when built with the slicelib_debug tag.
+func DifferenceSorted[T cmp.Ordered](a, b *OrderedSlice[T], mode SetMode) (*OrderedSlice[T], error) {
+	x, y, err := sortedPair(a, b, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []T
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] < y[j]:
+			out = append(out, x[i])
+			i++
+		case y[j] < x[i]:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	out = append(out, x[i:]...)
+	return fromSorted(out), nil
+}
+
+// KWayMerge merges any number of sorted slices into a new sorted slice,
+// keeping a heap of their heads, in O(n log k) for n elements in k slices.
+// With Multiset every element is kept, with Distinct each one appears once.
+//
+// If an input is not sorted, a sorted copy of it is used instead,
+// or ErrNotSorted is returned when built with the slicelib_debug tag.
+func KWayMerge[T cmp.Ordered](mode SetMode, s ...*OrderedSlice[T]) (*OrderedSlice[T], error) {
+	var (
+		n int
+		h = &mergeHeap[T]{}
+	)
+	for _, in := range s {
+		x, err := sortedInput(in, Multiset)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) > 0 {
+			h.cursors = append(h.cursors, x)
+			n += len(x)
+		}
+	}
+	heap.Init(h)
+
+	out := make([]T, 0, n)
+	for h.Len() > 0 {
+		head := h.cursors[0]
+		if v := head[0]; mode == Multiset || len(out) == 0 || out[len(out)-1] != v {
+			out = append(out, v)
+		}
+
+		if len(head) == 1 {
+			heap.Pop(h)
+			continue
+		}
+		h.cursors[0] = head[1:]
+		heap.Fix(h, 0)
+	}
+	return fromSorted(out), nil
+}
+
+// mergeHeap is a min-heap of the remaining elements of each input of KWayMerge,
+// ordered by their first element.
+type mergeHeap[T cmp.Ordered] struct {
+	cursors [][]T
+}
+
+func (h *mergeHeap[T]) Len() int           { return len(h.cursors) }
+func (h *mergeHeap[T]) Less(i, j int) bool { return h.cursors[i][0] < h.cursors[j][0] }
+func (h *mergeHeap[T]) Swap(i, j int)      { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+func (h *mergeHeap[T]) Push(x any)         { h.cursors = append(h.cursors, x.([]T)) }
+
+func (h *mergeHeap[T]) Pop() any {
+	n := len(h.cursors) - 1
+	x := h.cursors[n]
+	h.cursors = h.cursors[:n]
+	return x
+}
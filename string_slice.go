@@ -0,0 +1,168 @@
+package slicelib
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// StringSlice is an OrderedSlice of strings with text utilities:
+// natural and case-insensitive sorting, joining, mapping and filtering.
+type StringSlice struct {
+	*OrderedSlice[string]
+}
+
+// Creates a new StringSlice with the provided strings.
+//
+// Example:
+//
+//	files := NewStringSlice("file10", "file2", "File1")
+//	files.NaturalSort() // [File1 file2 file10]
+func NewStringSlice(slice ...string) *StringSlice {
+	return &StringSlice{NewOrderedSlice(slice...)}
+}
+
+// Creates a copy of the current object, which is not the same as the current object.
+func (s StringSlice) Clone() *StringSlice {
+	return &StringSlice{s.OrderedSlice.Clone()}
+}
+
+// NaturalSort sorts the strings in natural order, comparing runs of digits
+// by their numeric value, so that "file2" comes before "file10".
+// Letters are compared ignoring case. See NaturalCompare.
+func (s *StringSlice) NaturalSort() {
+	slices.SortStableFunc(s.slice, NaturalCompare)
+}
+
+// SortFold sorts the strings ignoring case differences,
+// keeping the original order of the strings that only differ in case.
+func (s *StringSlice) SortFold() {
+	slices.SortStableFunc(s.slice, compareFold)
+}
+
+// Join concatenates the strings, placing sep between them.
+// A shortcut to strings.Join.
+func (s StringSlice) Join(sep string) string {
+	return strings.Join(s.slice, sep)
+}
+
+// Map replaces every string with the result of calling f on it,
+// for example strings.ToUpper.
+func (s *StringSlice) Map(f func(string) string) {
+	for i, v := range s.slice {
+		s.slice[i] = f(v)
+	}
+}
+
+// TrimSpace removes the leading and trailing white space of every string.
+func (s *StringSlice) TrimSpace() {
+	s.Map(strings.TrimSpace)
+}
+
+// FilterPrefix keeps only the strings that begin with prefix.
+func (s *StringSlice) FilterPrefix(prefix string) {
+	s.Filter(func(v string) bool {
+		return strings.HasPrefix(v, prefix)
+	})
+}
+
+// FilterSuffix keeps only the strings that end with suffix.
+func (s *StringSlice) FilterSuffix(suffix string) {
+	s.Filter(func(v string) bool {
+		return strings.HasSuffix(v, suffix)
+	})
+}
+
+// FilterRegexp keeps only the strings that match re.
+func (s *StringSlice) FilterRegexp(re *regexp.Regexp) {
+	s.Filter(re.MatchString)
+}
+
+// RemoveDuplicatesFold removes the strings that only differ in case from a previous one,
+// keeping the first occurrence.
+func (s *StringSlice) RemoveDuplicatesFold() {
+	seen := make(map[string]bool)
+	s.Filter(func(v string) bool {
+		k := foldKey(v)
+		if seen[k] {
+			return false
+		}
+		seen[k] = true
+		return true
+	})
+}
+
+// foldKey returns a string equal for every string that compareFold considers equal.
+func foldKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(unicode.ToUpper(r))
+	}, s)
+}
+
+// NaturalCompare compares two strings in natural order: runs of digits are
+// compared by their numeric value, and the rest ignoring case.
+// Numbers that are equal except for leading zeros are ordered from the one with fewer zeros,
+// and strings equal in natural order are compared byte-wise,
+// so that the order is total.
+//
+// It can be used as a comparison function with SortFunc.
+func NaturalCompare(a, b string) int {
+	x, y := a, b
+	tie := 0
+	for x != "" && y != "" {
+		if isDigit(x[0]) && isDigit(y[0]) {
+			dx, dy := digitRun(x), digitRun(y)
+			nx, ny := strings.TrimLeft(x[:dx], "0"), strings.TrimLeft(y[:dy], "0")
+			// Longer numbers are greater, equal lengths compare lexically.
+			if r := cmp.Compare(len(nx), len(ny)); r != 0 {
+				return r
+			}
+			if r := strings.Compare(nx, ny); r != 0 {
+				return r
+			}
+			if tie == 0 {
+				tie = cmp.Compare(dx, dy)
+			}
+			x, y = x[dx:], y[dy:]
+			continue
+		}
+
+		tx, ty := textRun(x), textRun(y)
+		if r := compareFold(x[:tx], y[:ty]); r != 0 {
+			return r
+		}
+		x, y = x[tx:], y[ty:]
+	}
+
+	if r := cmp.Compare(len(x), len(y)); r != 0 {
+		return r
+	}
+	if tie != 0 {
+		return tie
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digitRun returns the length of the run of digits at the start of s.
+func digitRun(s string) int {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+// textRun returns the length of the run of non-digits at the start of s.
+func textRun(s string) int {
+	i := 0
+	for i < len(s) && !isDigit(s[i]) {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,69 @@
+package capabilities_test
+
+import (
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+// ring is a fixed-size ring buffer that only implements the capabilities
+// that make sense for it.
+type ring struct {
+	items []int
+	start int
+}
+
+func (r *ring) At(i int) int       { return r.items[(r.start+i)%len(r.items)] }
+func (r *ring) Set(i, v int)       { r.items[(r.start+i)%len(r.items)] = v }
+func (r *ring) Len() int           { return len(r.items) }
+func (r *ring) IsEmpty() bool      { return len(r.items) == 0 }
+func (r *ring) InRange(i int) bool { return i >= 0 && i < len(r.items) }
+
+func (r *ring) Range(f func(int, int) bool) {
+	for i := 0; i < r.Len(); i++ {
+		if !f(i, r.At(i)) {
+			return
+		}
+	}
+}
+
+func (r *ring) ReverseRange(f func(int, int) bool) {
+	for i := r.Len() - 1; i >= 0; i-- {
+		if !f(i, r.At(i)) {
+			return
+		}
+	}
+}
+
+var (
+	_ slicelib.Indexer[int] = (*ring)(nil)
+	_ slicelib.Setter[int]  = (*ring)(nil)
+	_ slicelib.Ranger[int]  = (*ring)(nil)
+)
+
+// sum only needs to index a sequence.
+func sum(s slicelib.Indexer[int]) (total int) {
+	for i := 0; i < s.Len(); i++ {
+		total += s.At(i)
+	}
+	return
+}
+
+func TestNarrowCapabilities(t *testing.T) {
+	r := &ring{items: []int{1, 2, 3, 4}, start: 2}
+
+	if got := slicelib.PVectorFrom[int](r); !got.Equal([]int{3, 4, 1, 2}) {
+		t.Fatalf("PVectorFrom(ring) = %v", got)
+	}
+
+	for _, s := range []slicelib.Indexer[int]{
+		r,
+		slicelib.NewSlice(1, 2, 3, 4),
+		slicelib.NewLinkedList(1, 2, 3, 4),
+		slicelib.NewPVector(1, 2, 3, 4),
+	} {
+		if got := sum(s); got != 10 {
+			t.Errorf("sum(%T) = %d, expected 10", s, got)
+		}
+	}
+}
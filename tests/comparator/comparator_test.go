@@ -0,0 +1,107 @@
+package comparator_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+type employee struct {
+	Name   string
+	Dept   string
+	Salary int
+}
+
+func names(s slicelib.ReadSlicer[employee]) (n []string) {
+	s.Range(func(_ int, e employee) bool {
+		n = append(n, e.Name)
+		return true
+	})
+	return
+}
+
+var staff = []employee{
+	{"bob", "ops", 100},
+	{"Alice", "dev", 120},
+	{"carol", "dev", 150},
+	{"dave", "ops", 100},
+	{"alex", "dev", 120},
+}
+
+func TestSortBy(t *testing.T) {
+	for name, s := range map[string]slicelib.Slicer[employee]{
+		"Slice":      slicelib.NewSlice(staff...),
+		"LinkedList": slicelib.NewLinkedList(staff...),
+	} {
+		slicelib.SortBy(s,
+			slicelib.By(func(e employee) string { return e.Dept }),
+			slicelib.By(func(e employee) int { return e.Salary }).Reverse(),
+			slicelib.CaseInsensitive(func(e employee) string { return e.Name }),
+		)
+		want := []string{"carol", "alex", "Alice", "bob", "dave"}
+		if got := names(s); !slices.Equal(got, want) {
+			t.Errorf("%s: SortBy = %v, expected %v", name, got, want)
+		}
+	}
+}
+
+func TestThen(t *testing.T) {
+	s := slicelib.NewSlice(staff...)
+	bySalary := slicelib.By(func(e employee) int { return e.Salary })
+	byName := slicelib.By(func(e employee) string { return e.Name })
+	s.SortFunc(bySalary.Then(byName))
+
+	want := []string{"bob", "dave", "Alice", "alex", "carol"}
+	if got := names(s); !slices.Equal(got, want) {
+		t.Errorf("Then = %v, expected %v", got, want)
+	}
+}
+
+func TestStableMultiPass(t *testing.T) {
+	// Sorting by the least significant key first gives the same result as SortBy.
+	s := slicelib.NewLinkedList(staff...)
+	s.SortStableFunc(slicelib.By(func(e employee) string { return e.Name }))
+	s.SortStableFunc(slicelib.By(func(e employee) string { return e.Dept }))
+
+	want := []string{"Alice", "alex", "carol", "bob", "dave"}
+	if got := names(s); !slices.Equal(got, want) {
+		t.Errorf("multi-pass SortStableFunc = %v, expected %v", got, want)
+	}
+}
+
+func TestNils(t *testing.T) {
+	one, two := 1, 2
+	values := []*int{&two, nil, &one, nil}
+	byValue := slicelib.Comparator[int](func(a, b int) int { return a - b })
+
+	first := slices.Clone(values)
+	slices.SortStableFunc(first, slicelib.NilsFirst(byValue))
+	if first[0] != nil || first[1] != nil || *first[2] != 1 || *first[3] != 2 {
+		t.Errorf("NilsFirst = %v", first)
+	}
+
+	last := slices.Clone(values)
+	slices.SortStableFunc(last, slicelib.NilsLast(byValue))
+	if *last[0] != 1 || *last[1] != 2 || last[2] != nil || last[3] != nil {
+		t.Errorf("NilsLast = %v", last)
+	}
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	fold := slicelib.CaseInsensitive(func(s string) string { return s })
+	for _, tt := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"Go", "go", 0},
+		{"ÉCOLE", "école", 0},
+		{"apple", "Banana", -1},
+		{"Zed", "alpha", 1},
+		{"ab", "AbC", -1},
+	} {
+		if got := fold(tt.a, tt.b); max(min(got, 1), -1) != tt.expected {
+			t.Errorf("CaseInsensitive(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package counter_test
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func vc[T any](v T, n int) slicelib.ValueCount[T] {
+	return slicelib.ValueCount[T]{Value: v, Count: n}
+}
+
+func TestComparableSlice(t *testing.T) {
+	s := slicelib.NewComparableSlice(strings.Fields("b a c a b d a")...)
+
+	if s.Count("a") != 3 || s.Count("z") != 0 {
+		t.Errorf("Count(a), Count(z) = %d, %d", s.Count("a"), s.Count("z"))
+	}
+
+	expected := map[string]int{"a": 3, "b": 2, "c": 1, "d": 1}
+	if f := s.Frequencies(); !maps.Equal(f, expected) {
+		t.Errorf("Frequencies = %v, expected %v", f, expected)
+	}
+
+	// c and d are tied, c comes first as it appears first.
+	common := s.MostCommon(-1)
+	want := []slicelib.ValueCount[string]{vc("a", 3), vc("b", 2), vc("c", 1), vc("d", 1)}
+	if !slices.Equal(common, want) {
+		t.Errorf("MostCommon(-1) = %v, expected %v", common, want)
+	}
+	if top := s.MostCommon(2); !slices.Equal(top, want[:2]) {
+		t.Errorf("MostCommon(2) = %v", top)
+	}
+}
+
+func TestStableTies(t *testing.T) {
+	for range 20 {
+		s := slicelib.NewComparableSlice(5, 3, 9, 1, 7)
+		want := []slicelib.ValueCount[int]{vc(5, 1), vc(3, 1), vc(9, 1)}
+		if got := s.MostCommon(3); !slices.Equal(got, want) {
+			t.Fatalf("MostCommon(3) = %v, expected %v", got, want)
+		}
+	}
+}
+
+func TestCustomEquality(t *testing.T) {
+	s := slicelib.NewComparableSlice("Go", "go", "Rust", "GO")
+	s.SetEquality(strings.EqualFold)
+
+	if n := s.Count("gO"); n != 3 {
+		t.Errorf("Count with EqualFold = %d", n)
+	}
+	if f := s.Frequencies(); !maps.Equal(f, map[string]int{"Go": 3, "Rust": 1}) {
+		t.Errorf("Frequencies with EqualFold = %v", f)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	c := slicelib.CounterOf[string](slicelib.NewLinkedList("x", "y", "x"))
+	if c.Get("x") != 2 || c.Get("y") != 1 || c.Len() != 2 || c.Total() != 3 {
+		t.Fatalf("CounterOf = %v", c.Map())
+	}
+
+	c.Add("z", "z")
+	c.Subtract("y", "y")
+	if c.Get("y") != 0 || c.Len() != 2 {
+		t.Errorf("Subtract should drop values that reach zero: %v", c.Map())
+	}
+
+	other := slicelib.NewCounter("w", "x")
+	c.Merge(other)
+	want := []slicelib.ValueCount[string]{vc("x", 3), vc("z", 2), vc("w", 1)}
+	if got := c.MostCommon(-1); !slices.Equal(got, want) {
+		t.Errorf("after Merge, MostCommon = %v, expected %v", got, want)
+	}
+
+	clone := c.Clone()
+	clone.SubtractCounter(slicelib.NewCounter("x", "x", "x", "x", "w"))
+	if !maps.Equal(clone.Map(), map[string]int{"z": 2}) {
+		t.Errorf("SubtractCounter = %v", clone.Map())
+	}
+	if c.Get("x") != 3 {
+		t.Error("modifying a clone changed the original")
+	}
+
+	// A value removed and added again goes to the back of the ties.
+	c.Subtract("w")
+	c.AddCount("x", -1)
+	c.Add("w", "w")
+	want = []slicelib.ValueCount[string]{vc("x", 2), vc("z", 2), vc("w", 2)}
+	if got := c.MostCommon(-1); !slices.Equal(got, want) {
+		t.Errorf("MostCommon = %v, expected %v", got, want)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var c slicelib.Counter[int]
+	if c.Get(1) != 0 || c.Len() != 0 || c.Total() != 0 || len(c.MostCommon(-1)) != 0 {
+		t.Fatal("a zero Counter should be empty")
+	}
+	c.Subtract(1)
+
+	c.Add(2, 1, 2)
+	c.Merge(slicelib.NewCounter(3))
+	want := []slicelib.ValueCount[int]{vc(2, 2), vc(1, 1), vc(3, 1)}
+	if common := c.MostCommon(-1); !slices.Equal(common, want) {
+		t.Errorf("MostCommon(-1) = %v, expected %v", common, want)
+	}
+
+	var other slicelib.Counter[int]
+	c.Merge(&other)
+	c.SubtractCounter(&other)
+	if clone := other.Clone(); clone.Len() != 0 || c.Total() != 4 {
+		t.Errorf("Clone of a zero Counter = %v, Total = %d", clone.Map(), c.Total())
+	}
+}
@@ -0,0 +1,56 @@
+package cow_test
+
+import (
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestCopyOnWrite(t *testing.T) {
+	a := slicelib.NewCOWSlice(3, 1, 2)
+	b := a.Clone()
+	c := b.Clone()
+
+	if !a.Shared() || !b.Shared() || !c.Shared() {
+		t.Fatal("clones should share the backing array")
+	}
+
+	b.Append(4)
+	c.SortFunc(func(x, y int) int { return x - y })
+	a.Set(0, 10)
+
+	for _, tt := range []struct {
+		name     string
+		s        *slicelib.COWSlice[int]
+		expected []int
+	}{
+		{"a", a, []int{10, 1, 2}},
+		{"b", b, []int{3, 1, 2, 4}},
+		{"c", c, []int{1, 2, 3}},
+	} {
+		if !tt.s.Equal(tt.expected) {
+			t.Errorf("%s = %v, expected %v", tt.name, tt.s, tt.expected)
+		}
+		if tt.s.Shared() {
+			t.Errorf("%s should own its array after being mutated", tt.name)
+		}
+	}
+}
+
+func TestEscapedStorage(t *testing.T) {
+	a := slicelib.NewCOWSlice(1, 2, 3)
+	b := a.Clone()
+
+	// S detaches the receiver, so writing through it must not reach the clone.
+	a.S()[0] = 100
+	if !b.Equal([]int{1, 2, 3}) {
+		t.Fatalf("writing through S() modified a clone: %v", b)
+	}
+
+	// Clones of escaped storage are real copies.
+	c := a.Clone()
+	(*a.SliceP())[1] = 200
+	if c.Shared() || !c.Equal([]int{100, 2, 3}) {
+		t.Fatalf("clone of an escaped COWSlice shares storage: %v", c)
+	}
+}
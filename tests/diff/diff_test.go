@@ -0,0 +1,171 @@
+package diff_test
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+// lcs returns the length of the longest common subsequence of a and b.
+func lcs(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func random(r *rand.Rand) []int {
+	s := make([]int, r.IntN(15))
+	for i := range s {
+		s[i] = r.IntN(4)
+	}
+	return s
+}
+
+func TestDiff(t *testing.T) {
+	r := rand.New(rand.NewPCG(11, 12))
+	for range 500 {
+		a, b := random(r), random(r)
+		edits := slicelib.Diff[int](slicelib.NewLinkedList(a...), slicelib.NewSlice(b...))
+
+		var (
+			fromA, toB []int
+			changes    int
+		)
+		for k, e := range edits {
+			if k > 0 && edits[k-1].Op == e.Op {
+				t.Fatalf("consecutive edits with the same operation: %v", edits)
+			}
+			if e.Op != slicelib.EditInsert {
+				if !slicesEqual(e.Values, a[e.A:e.A+len(e.Values)]) {
+					t.Fatalf("edit %v does not match a = %v", e, a)
+				}
+				fromA = append(fromA, e.Values...)
+			}
+			if e.Op != slicelib.EditDelete {
+				if !slicesEqual(e.Values, b[e.B:e.B+len(e.Values)]) {
+					t.Fatalf("edit %v does not match b = %v", e, b)
+				}
+				toB = append(toB, e.Values...)
+			}
+			if e.Op != slicelib.EditEqual {
+				changes += len(e.Values)
+			}
+		}
+
+		if !slicesEqual(fromA, a) || !slicesEqual(toB, b) {
+			t.Fatalf("Diff(%v, %v) = %v does not cover both sequences", a, b, edits)
+		}
+		// The script is the shortest one.
+		if want := len(a) + len(b) - 2*lcs(a, b); changes != want {
+			t.Fatalf("Diff(%v, %v) has %d changes, expected %d", a, b, changes, want)
+		}
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	// Two mostly different sequences, sharing every 100th element.
+	const n = 5000
+	a, b := make([]int, n), make([]int, n)
+	for i := range a {
+		a[i] = i
+		b[i] = n + i
+		if i%100 == 0 {
+			b[i] = i
+		}
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := slicelib.Diff[int](slicelib.NewSlice(a...), slicelib.NewSlice(b...))
+	runtime.ReadMemStats(&after)
+
+	// The space used is linear, not quadratic, in the number of differences.
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 16<<20 {
+		t.Errorf("Diff allocated %d bytes", alloc)
+	}
+
+	var equal, changes int
+	for _, e := range edits {
+		if e.Op == slicelib.EditEqual {
+			equal += len(e.Values)
+		} else {
+			changes += len(e.Values)
+		}
+	}
+	if equal != n/100 || changes != 2*(n-n/100) {
+		t.Errorf("Diff kept %d elements with %d changes, expected %d and %d",
+			equal, changes, n/100, 2*(n-n/100))
+	}
+}
+
+func slicesEqual(a, b []int) bool {
+	return slicelib.NewSlice(a...).Equal(b)
+}
+
+func TestDiffFunc(t *testing.T) {
+	a := slicelib.NewSlice("Go", "is", "fun")
+	b := slicelib.NewSlice("go", "IS", "fun")
+	edits := slicelib.DiffFunc[string](a, b, strings.EqualFold)
+	if len(edits) != 1 || edits[0].Op != slicelib.EditEqual {
+		t.Fatalf("DiffFunc = %v", edits)
+	}
+}
+
+func TestUnified(t *testing.T) {
+	a := slicelib.NewSlice("a", "b", "c", "d", "e", "f", "g", "h", "i")
+	b := slicelib.NewSlice("a", "B", "c", "d", "e", "f", "g", "h", "i", "j")
+
+	expected := `@@ -1,3 +1,3 @@
+ a
+-b
++B
+ c
+@@ -9 +9,2 @@
+ i
++j
+`
+	if got := slicelib.Unified(slicelib.Diff[string](a, b), 1); got != expected {
+		t.Errorf("Unified =\n%s\nexpected\n%s", got, expected)
+	}
+
+	// Changes separated by up to twice the context share a hunk.
+	expected = `@@ -1,9 +1,10 @@
+ a
+-b
++B
+ c
+ d
+ e
+ f
+ g
+ h
+ i
++j
+`
+	if got := slicelib.Unified(slicelib.Diff[string](a, b), 4); got != expected {
+		t.Errorf("Unified =\n%s\nexpected\n%s", got, expected)
+	}
+
+	if got := slicelib.Unified(slicelib.Diff[string](a, a), 3); got != "" {
+		t.Errorf("Unified of equal sequences = %q", got)
+	}
+
+	expected = "@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if got := slicelib.Unified(slicelib.Diff[string](slicelib.NewSlice[string](), slicelib.NewSlice("x", "y")), 3); got != expected {
+		t.Errorf("Unified from an empty sequence = %q", got)
+	}
+}
@@ -0,0 +1,212 @@
+package equality_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestEqualerElements(t *testing.T) {
+	// The same instant in two locations: == says they differ, Equal says they don't.
+	utc := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	local := utc.In(time.FixedZone("UTC+2", 2*60*60))
+
+	for name, s := range map[string]slicelib.Slicer[time.Time]{
+		"Slice":           slicelib.NewSlice(local),
+		"ComparableSlice": slicelib.NewComparableSlice(local),
+		"LinkedList":      slicelib.NewLinkedList(local),
+		"UnrolledList":    slicelib.NewUnrolledList(local),
+		"GapBuffer":       slicelib.NewGapBuffer(local),
+	} {
+		if s.Index(utc) != 0 || !s.Contains(utc) || !s.Equal([]time.Time{utc}) {
+			t.Errorf("%s does not use time.Time.Equal", name)
+		}
+
+		s.Append(utc)
+		s.RemoveDuplicates()
+		if s.Len() != 1 {
+			t.Errorf("%s: RemoveDuplicates kept %d equal instants", name, s.Len())
+		}
+	}
+}
+
+func TestEqualityStrategy(t *testing.T) {
+	makers := map[string]func(...string) slicelib.Slicer[string]{
+		"Slice": func(s ...string) slicelib.Slicer[string] {
+			return slicelib.NewSliceWithEquality(strings.EqualFold, s...)
+		},
+		"ComparableSlice": func(s ...string) slicelib.Slicer[string] {
+			cs := slicelib.NewComparableSlice(s...)
+			cs.SetEquality(strings.EqualFold)
+			return cs
+		},
+		"LinkedList": func(s ...string) slicelib.Slicer[string] {
+			return slicelib.NewLinkedListWithEquality(strings.EqualFold, s...)
+		},
+		"UnrolledList": func(s ...string) slicelib.Slicer[string] {
+			ul := slicelib.NewUnrolledListSize(2, s...)
+			ul.SetEquality(strings.EqualFold)
+			return ul
+		},
+		"View": func(s ...string) slicelib.Slicer[string] {
+			parent := slicelib.NewSliceWithEquality(strings.EqualFold, s...)
+			return parent.View(0, len(s))
+		},
+	}
+
+	for name, maker := range makers {
+		s := maker("Go", "rust", "GO", "Zig", "go")
+
+		if s.Index("go") != 0 || s.LastIndex("gO") != 4 || !s.Contains("RUST") {
+			t.Errorf("%s: lookups ignore the strategy: %v", name, s)
+		}
+		if !s.Equal([]string{"go", "Rust", "go", "zig", "GO"}) {
+			t.Errorf("%s: Equal ignores the strategy", name)
+		}
+
+		s.RemoveLast("go")
+		s.Remove("ZIG")
+		if !s.Equal([]string{"Go", "rust", "GO"}) {
+			t.Errorf("%s: Remove/RemoveLast ignore the strategy: %v", name, s)
+		}
+
+		s.RemoveDuplicates()
+		if s.Len() != 2 || s.At(0) != "Go" {
+			t.Errorf("%s: RemoveDuplicates ignores the strategy: %v", name, s)
+		}
+	}
+}
+
+func TestNonComparableDuplicates(t *testing.T) {
+	s := slicelib.NewSlice([]int{1}, []int{2}, []int{1})
+	s.RemoveDuplicates()
+	if s.Len() != 2 {
+		t.Fatalf("RemoveDuplicates on slices of slices: %v", s)
+	}
+}
+
+type user struct {
+	ID   int
+	Tags []string
+}
+
+func TestRemoveDuplicatesVariants(t *testing.T) {
+	users := []user{{1, []string{"a"}}, {2, nil}, {1, []string{"b"}}, {3, nil}, {2, []string{"c"}}}
+	byID := func(u user) int { return u.ID }
+
+	for _, keep := range []slicelib.Keep{slicelib.KeepFirst, slicelib.KeepLast} {
+		for _, s := range []slicelib.Slicer[user]{
+			slicelib.NewSlice(users...),
+			slicelib.NewLinkedList(users...),
+		} {
+			slicelib.RemoveDuplicatesFunc(s, byID, keep)
+			if s.Len() != 3 {
+				t.Fatalf("RemoveDuplicatesFunc(%v) kept %d elements", keep, s.Len())
+			}
+
+			s2 := slicelib.NewSlice(users...)
+			slicelib.RemoveDuplicatesEqual(s2, func(a, b user) bool { return a.ID == b.ID }, keep)
+			if !s.EqualSlicer(s2) {
+				t.Fatalf("RemoveDuplicatesEqual(%v) = %v, expected %v", keep, s2, s)
+			}
+		}
+	}
+
+	s := slicelib.NewSlice(users...)
+	slicelib.RemoveDuplicatesFunc(s, byID, slicelib.KeepLast)
+	if ids := []int{s.At(0).ID, s.At(1).ID, s.At(2).ID}; ids[0] != 1 || ids[1] != 3 || ids[2] != 2 {
+		t.Fatalf("KeepLast should keep the order of the last occurrences, got %v", ids)
+	}
+
+	// Non-comparable values stored in interfaces must not panic.
+	mixed := slicelib.NewSlice[any](1, []int{1}, 1, []int{1}, "a")
+	mixed.RemoveDuplicates()
+	if mixed.Len() != 3 {
+		t.Fatalf("RemoveDuplicates on mixed interface values: %v", mixed)
+	}
+}
+
+type boxed struct {
+	V any
+}
+
+func TestInterfaceFields(t *testing.T) {
+	// Structs and arrays of interfaces are comparable types,
+	// but == panics when an interface holds a slice.
+	for name, s := range map[string]slicelib.Slicer[boxed]{
+		"Slice":      slicelib.NewSlice(boxed{[]int{1}}, boxed{1}, boxed{[]int{1}}, boxed{1}),
+		"LinkedList": slicelib.NewLinkedList(boxed{[]int{1}}, boxed{1}, boxed{[]int{1}}, boxed{1}),
+	} {
+		if i := s.Index(boxed{[]int{1}}); i != 0 {
+			t.Errorf("%s: Index = %d, expected 0", name, i)
+		}
+		if i := s.LastIndex(boxed{1}); i != 3 {
+			t.Errorf("%s: LastIndex = %d, expected 3", name, i)
+		}
+		s.RemoveDuplicates()
+		if s.Len() != 2 {
+			t.Errorf("%s: RemoveDuplicates kept %d elements: %v", name, s.Len(), s)
+		}
+	}
+
+	arrays := slicelib.NewSlice([1]any{[]int{1}}, [1]any{[]int{1}}, [1]any{"a"})
+	if !arrays.Contains([1]any{"a"}) {
+		t.Error("Contains on arrays of interfaces")
+	}
+	arrays.RemoveDuplicates()
+	if arrays.Len() != 2 {
+		t.Errorf("RemoveDuplicates on arrays of interfaces: %v", arrays)
+	}
+
+	q := slicelib.NewQuery[boxed](slicelib.NewSlice(boxed{[]int{1}}, boxed{[]int{1}}))
+	if n := q.Distinct().Count(); n != 1 {
+		t.Errorf("Query.Distinct kept %d elements", n)
+	}
+}
+
+func TestEmptyAndCapacity(t *testing.T) {
+	makers := map[string]func(...int) slicelib.ReadSlicer[int]{
+		"Slice":        func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewSlice(i...) },
+		"LinkedList":   func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewLinkedList(i...) },
+		"UnrolledList": func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewUnrolledList(i...) },
+		"GapBuffer":    func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewGapBuffer(i...) },
+		"COWSlice":     func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewCOWSlice(i...) },
+		"PVector":      func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewPVector(i...) },
+		"IndexedSlice": func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewIndexedSlice(i...) },
+		"Immutable":    func(i ...int) slicelib.ReadSlicer[int] { return slicelib.NewImmutableSlice(i...) },
+		"View": func(i ...int) slicelib.ReadSlicer[int] {
+			return slicelib.NewSlice(append([]int{0}, i...)...).View(1, len(i)+1)
+		},
+	}
+
+	spare := make([]int, 2, 10)
+	spare[0], spare[1] = 1, 2
+	for name, maker := range makers {
+		empty := maker()
+		if !empty.Equal(nil) || !empty.Equal([]int{}) || !empty.Equal(make([]int, 0, 4)) {
+			t.Errorf("%s: an empty sequence should equal an empty slice", name)
+		}
+		if !maker(1, 2).Equal(spare) {
+			t.Errorf("%s: Equal should ignore the capacity of the slice", name)
+		}
+
+		for other, maker2 := range makers {
+			if !empty.EqualSlicer(maker2()) {
+				t.Errorf("%s: an empty sequence should equal an empty %s", name, other)
+			}
+			if !maker(1, 2).EqualSlicer(maker2(1, 2)) || maker(1, 2).EqualSlicer(maker2(1, 3)) {
+				t.Errorf("%s: EqualSlicer with %s compares more than the elements", name, other)
+			}
+		}
+	}
+
+	// Two Slices with different capacities.
+	a := slicelib.NewSlice(1, 2)
+	b := slicelib.NewSlice(1, 2, 3)
+	b.SliceRight(2)
+	if !a.EqualSlicer(b) {
+		t.Error("EqualSlicer should ignore the capacity of the Slices")
+	}
+}
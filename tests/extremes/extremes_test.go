@@ -0,0 +1,112 @@
+package extremes_test
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+type product struct {
+	Name  string
+	Price int
+}
+
+func byPrice(a, b product) int {
+	return cmp.Compare(a.Price, b.Price)
+}
+
+func TestExtremesFunc(t *testing.T) {
+	items := []product{{"a", 30}, {"b", 10}, {"c", 50}, {"d", 10}, {"e", 50}}
+
+	for name, s := range map[string]slicelib.Slicer[product]{
+		"Slice":      slicelib.NewSlice(items...),
+		"LinkedList": slicelib.NewLinkedList(items...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Ties resolve to the first element.
+			if v := slicelib.MinFunc(s, byPrice); v.Name != "b" {
+				t.Errorf("MinFunc = %v", v)
+			}
+			if v := slicelib.MaxFunc(s, byPrice); v.Name != "c" {
+				t.Errorf("MaxFunc = %v", v)
+			}
+			if i := slicelib.ArgMinFunc(s, byPrice); i != 1 {
+				t.Errorf("ArgMinFunc = %d", i)
+			}
+			if i := slicelib.ArgMaxFunc(s, byPrice); i != 2 {
+				t.Errorf("ArgMaxFunc = %d", i)
+			}
+		})
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s := slicelib.NewLinkedList[product]()
+	if slicelib.ArgMinFunc(s, byPrice) != -1 || slicelib.ArgMaxFunc(s, byPrice) != -1 {
+		t.Error("ArgMinFunc and ArgMaxFunc of an empty sequence should be -1")
+	}
+	if slicelib.TopK(s, 3, byPrice) != nil {
+		t.Error("TopK of an empty sequence should be empty")
+	}
+
+	for name, f := range map[string]func(){
+		"MinFunc": func() { slicelib.MinFunc(s, byPrice) },
+		"MaxFunc": func() { slicelib.MaxFunc(s, byPrice) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s of an empty sequence should panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestTopK(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for range 100 {
+		items := make([]int, r.IntN(50))
+		for i := range items {
+			items[i] = r.IntN(20)
+		}
+		k := r.IntN(10)
+
+		sorted := slices.Clone(items)
+		slices.Sort(sorted)
+		bottom := slices.Clone(sorted[:min(k, len(sorted))])
+		slices.Reverse(sorted)
+		top := sorted[:min(k, len(sorted))]
+
+		s := slicelib.NewOrderedSlice(items...)
+		if got := s.TopK(k); !slices.Equal(got, top) {
+			t.Fatalf("TopK(%v, %d) = %v, expected %v", items, k, got, top)
+		}
+		if got := s.BottomK(k); !slices.Equal(got, bottom) {
+			t.Fatalf("BottomK(%v, %d) = %v, expected %v", items, k, got, bottom)
+		}
+	}
+}
+
+func TestOrderedShortcuts(t *testing.T) {
+	s := slicelib.NewOrderedSlice(3, 9, 1, 9, 1)
+	if s.Min() != 1 || s.Max() != 9 {
+		t.Errorf("Min, Max = %d, %d", s.Min(), s.Max())
+	}
+	if lo, hi := s.MinMax(); lo != 1 || hi != 9 {
+		t.Errorf("MinMax = %d, %d", lo, hi)
+	}
+	if s.ArgMin() != 2 || s.ArgMax() != 1 {
+		t.Errorf("ArgMin, ArgMax = %d, %d", s.ArgMin(), s.ArgMax())
+	}
+	if top := s.TopK(2); !slices.Equal(top, []int{9, 9}) {
+		t.Errorf("TopK(2) = %v", top)
+	}
+	if bottom := s.BottomK(3); !slices.Equal(bottom, []int{1, 1, 3}) {
+		t.Errorf("BottomK(3) = %v", bottom)
+	}
+}
@@ -0,0 +1,145 @@
+package fuzzy_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func values[T any](matches []slicelib.Match[T]) []T {
+	var v []T
+	for _, m := range matches {
+		v = append(v, m.Value)
+	}
+	return v
+}
+
+func TestFuzzyFind(t *testing.T) {
+	files := slicelib.NewSlice(
+		"README.md",
+		"slice_main_test.go",
+		"src/main.go",
+		"mongo.go",
+		"docs/manual.txt",
+	)
+
+	matches := slicelib.FuzzyFind(files, "mgo")
+	expected := []string{"mongo.go", "src/main.go", "slice_main_test.go"}
+	if got := values(matches); !slices.Equal(got, expected) {
+		t.Errorf("FuzzyFind = %q, expected %q", got, expected)
+	}
+
+	for _, m := range matches {
+		if files.At(m.Index) != m.Value {
+			t.Errorf("Index %d does not point to %q", m.Index, m.Value)
+		}
+		runes := []rune(m.Value)
+		var matched strings.Builder
+		for _, p := range m.Positions {
+			matched.WriteRune(runes[p])
+		}
+		if strings.ToLower(matched.String()) != "mgo" || !slices.IsSorted(m.Positions) {
+			t.Errorf("Positions %v of %q do not spell the query", m.Positions, m.Value)
+		}
+	}
+
+	// Ranked by score.
+	for i := 1; i < len(matches); i++ {
+		if matches[i-1].Score < matches[i].Score {
+			t.Errorf("%q scored %d, ranked before %q with %d",
+				matches[i-1].Value, matches[i-1].Score, matches[i].Value, matches[i].Score)
+		}
+	}
+}
+
+func TestBoundaries(t *testing.T) {
+	// The match at word starts wins over an earlier scattered one.
+	m := slicelib.FuzzyFind(slicelib.NewSlice("xfxoxo foo_bar"), "fb")
+	if len(m) != 1 || !slices.Equal(m[0].Positions, []int{7, 11}) {
+		t.Errorf("Positions = %v, expected [7 11]", m)
+	}
+
+	// Consecutive runes beat runes spread over the string.
+	m = slicelib.FuzzyFind(slicelib.NewSlice("a_b_c_d", "abcd"), "abcd")
+	if got := values(m); !slices.Equal(got, []string{"abcd", "a_b_c_d"}) {
+		t.Errorf("FuzzyFind = %q", got)
+	}
+
+	// camelCase humps count as word starts.
+	m = slicelib.FuzzyFind(slicelib.NewSlice("getxvaluebyid", "getValueById"), "gvbi")
+	if got := values(m); len(got) != 2 || got[0] != "getValueById" {
+		t.Errorf("FuzzyFind = %q", got)
+	}
+}
+
+func TestSmartCase(t *testing.T) {
+	s := slicelib.NewStringSlice("Makefile", "makefile", "MAKEFILE")
+	if got := values(s.FuzzyFind("mk")); len(got) != 3 {
+		t.Errorf("Lowercase query should ignore case, got %q", got)
+	}
+	if got := values(s.FuzzyFind("Mk")); !slices.Equal(got, []string{"Makefile"}) {
+		t.Errorf("Uppercase query should respect case, got %q", got)
+	}
+}
+
+func TestTies(t *testing.T) {
+	// Equal scores are ranked by length, then by position.
+	m := slicelib.FuzzyFind(slicelib.NewSlice("abx", "ab", "aby"), "ab")
+	if got := values(m); !slices.Equal(got, []string{"ab", "abx", "aby"}) {
+		t.Errorf("FuzzyFind = %q", got)
+	}
+}
+
+func TestEmptyAndMissing(t *testing.T) {
+	s := slicelib.NewLinkedList("b", "a")
+	m := slicelib.FuzzyFind(s, "")
+	if got := values(m); !slices.Equal(got, []string{"b", "a"}) || m[0].Score != 0 || m[0].Positions != nil {
+		t.Errorf("Empty query = %+v", m)
+	}
+	if m := slicelib.FuzzyFind(s, "z"); len(m) != 0 {
+		t.Errorf("FuzzyFind = %+v, expected no matches", m)
+	}
+	if m := slicelib.FuzzyFind(s, "ab"); len(m) != 0 {
+		t.Errorf("Runes out of order should not match, got %+v", m)
+	}
+}
+
+func TestUnicode(t *testing.T) {
+	m := slicelib.FuzzyFind(slicelib.NewSlice("año_nuevo"), "ñn")
+	if len(m) != 1 || !slices.Equal(m[0].Positions, []int{1, 4}) {
+		t.Errorf("Positions = %+v, expected rune positions [1 4]", m)
+	}
+}
+
+type product struct {
+	Name  string
+	Price int
+}
+
+func TestFuzzyFindFunc(t *testing.T) {
+	products := slicelib.NewSlice(
+		product{Name: "Coffee Mug", Price: 12},
+		product{Name: "Tea Cup", Price: 8},
+		product{Name: "Coffee Grinder", Price: 40},
+	)
+	m := slicelib.FuzzyFindFunc(products, "cofmug", func(p product) string { return p.Name })
+	if len(m) != 1 || m[0].Value.Price != 12 || m[0].Index != 0 {
+		t.Errorf("FuzzyFindFunc = %+v", m)
+	}
+}
+
+func BenchmarkFuzzyFind(b *testing.B) {
+	items := make([]string, 5000)
+	for i := range items {
+		items[i] = fmt.Sprintf("pkg/module%d/file_%d_handler.go", i%50, i)
+	}
+	s := slicelib.NewSlice(items...)
+
+	b.ResetTimer()
+	for range b.N {
+		slicelib.FuzzyFind(s, "m7fhgo")
+	}
+}
@@ -0,0 +1,51 @@
+package gapbuffer_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestEdits(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	gb := slicelib.NewGapBuffer([]rune("hello world")...)
+	expected := []rune("hello world")
+
+	for i := 0; i < 2000; i++ {
+		cursor := r.IntN(len(expected) + 1)
+		switch r.IntN(4) {
+		case 0:
+			gb.MoveGap(cursor)
+			if gb.Cursor() != cursor {
+				t.Fatalf("step %d: cursor at %d, expected %d", i, gb.Cursor(), cursor)
+			}
+		case 1, 2:
+			c := 'a' + rune(r.IntN(26))
+			gb.Insert(cursor, c)
+			expected = slices.Insert(expected, cursor, c)
+		default:
+			if cursor == len(expected) {
+				continue
+			}
+			gb.Pop(cursor)
+			expected = slices.Delete(expected, cursor, cursor+1)
+		}
+
+		if !gb.Equal(expected) {
+			t.Fatalf("step %d: got %q, expected %q", i, string(gb.S()), string(expected))
+		}
+	}
+
+	i, j := len(expected)/4, len(expected)/2
+	gb.MoveGap(len(expected) / 3)
+	if got := gb.Extract(i, j); !slices.Equal(got, expected[i:j]) {
+		t.Fatalf("Extract(%d, %d) = %q, expected %q", i, j, string(got), string(expected[i:j]))
+	}
+
+	gb.SliceRange(i, j)
+	if !gb.Equal(expected[i:j]) {
+		t.Fatalf("SliceRange(%d, %d) = %q, expected %q", i, j, string(gb.S()), string(expected[i:j]))
+	}
+}
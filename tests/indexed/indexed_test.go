@@ -0,0 +1,229 @@
+package indexed_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+// check compares every lookup of s with a linear scan of the reference slice.
+func check(t *testing.T, step int, op string, s *slicelib.IndexedSlice[int], ref []int) {
+	t.Helper()
+
+	if !s.Equal(ref) {
+		t.Fatalf("step %d (%s): %v, expected %v", step, op, s, ref)
+	}
+
+	distinct := make(map[int]bool)
+	for _, v := range ref {
+		distinct[v] = true
+	}
+	// The index must not keep entries for values that are no longer present.
+	if s.IndexSize() != len(distinct) {
+		t.Fatalf("step %d (%s): index holds %d values, expected %d", step, op, s.IndexSize(), len(distinct))
+	}
+
+	for v := -1; v < 10; v++ {
+		if got, want := s.Index(v), slices.Index(ref, v); got != want {
+			t.Fatalf("step %d (%s): Index(%d) = %d, expected %d", step, op, v, got, want)
+		}
+		want := -1
+		for i := len(ref) - 1; i >= 0; i-- {
+			if ref[i] == v {
+				want = i
+				break
+			}
+		}
+		if got := s.LastIndex(v); got != want {
+			t.Fatalf("step %d (%s): LastIndex(%d) = %d, expected %d", step, op, v, got, want)
+		}
+		if got := s.Contains(v); got != distinct[v] {
+			t.Fatalf("step %d (%s): Contains(%d) = %t", step, op, v, got)
+		}
+	}
+}
+
+func TestConsistency(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := slicelib.NewIndexedSlice[int]()
+	var ref []int
+
+	values := func() []int {
+		items := make([]int, r.IntN(4))
+		for i := range items {
+			items[i] = r.IntN(10)
+		}
+		return items
+	}
+
+	for step := range 3000 {
+		var op string
+		switch r.IntN(12) {
+		case 0:
+			op = "Append"
+			items := values()
+			s.Append(items...)
+			ref = append(ref, items...)
+		case 1:
+			op = "Insert"
+			i, items := r.IntN(len(ref)+1), values()
+			s.Insert(i, items...)
+			ref = slices.Insert(ref, i, items...)
+		case 2:
+			if len(ref) == 0 {
+				continue
+			}
+			op = "Set"
+			i, v := r.IntN(len(ref)), r.IntN(10)
+			s.Set(i, v)
+			ref[i] = v
+		case 3:
+			op = "Delete"
+			j := r.IntN(len(ref) + 1)
+			i := r.IntN(j + 1)
+			s.Delete(i, j)
+			ref = slices.Delete(ref, i, j)
+		case 4:
+			if len(ref) == 0 {
+				continue
+			}
+			op = "Pop"
+			i := r.IntN(len(ref))
+			s.Pop(i)
+			ref = slices.Delete(ref, i, i+1)
+		case 5:
+			v := r.IntN(10)
+			i := slices.Index(ref, v)
+			if i == -1 {
+				continue
+			}
+			op = "Remove"
+			s.Remove(v)
+			ref = slices.Delete(ref, i, i+1)
+		case 6:
+			op = "SortFunc"
+			cmp := func(a, b int) int { return b - a }
+			s.SortFunc(cmp)
+			slices.SortFunc(ref, cmp)
+		case 7:
+			op = "Reverse"
+			s.Reverse()
+			slices.Reverse(ref)
+		case 8:
+			op = "Filter"
+			n := r.IntN(10)
+			s.Filter(func(v int) bool { return v != n })
+			ref = slices.DeleteFunc(ref, func(v int) bool { return v == n })
+		case 9:
+			op = "SliceRange"
+			j := r.IntN(len(ref) + 1)
+			i := r.IntN(j + 1)
+			s.SliceRange(i, j)
+			ref = ref[i:j]
+		case 10:
+			op = "View"
+			j := r.IntN(len(ref) + 1)
+			i := r.IntN(j + 1)
+			items := values()
+			s.View(i, j).Append(items...)
+			ref = slices.Insert(ref, j, items...)
+		case 11:
+			if r.IntN(10) != 0 {
+				continue
+			}
+			op = "Clear"
+			s.Clear()
+			ref = nil
+		}
+		check(t, step, op, s, ref)
+	}
+}
+
+func TestPositions(t *testing.T) {
+	s := slicelib.NewIndexedSlice(5, 1, 5, 2, 5)
+	if p := s.Positions(5); !slices.Equal(p, []int{0, 2, 4}) {
+		t.Fatalf("Positions(5) = %v", p)
+	}
+	if s.Count(5) != 3 || s.Count(7) != 0 {
+		t.Fatalf("Count(5) = %d, Count(7) = %d", s.Count(5), s.Count(7))
+	}
+
+	// The returned positions must be a copy.
+	s.Positions(5)[0] = 100
+	if s.Index(5) != 0 {
+		t.Fatal("modifying the result of Positions changed the index")
+	}
+
+	s.RemoveLast(5)
+	if p := s.Positions(5); !slices.Equal(p, []int{0, 2}) {
+		t.Fatalf("Positions(5) after RemoveLast = %v", p)
+	}
+}
+
+func TestReindex(t *testing.T) {
+	s := slicelib.NewIndexedSlice(1, 2, 3)
+	s.S()[0] = 4
+	s.Reindex()
+	if s.Contains(1) || s.Index(4) != 0 {
+		t.Fatalf("index not rebuilt: Contains(1) = %t, Index(4) = %d", s.Contains(1), s.Index(4))
+	}
+
+	p := s.SliceP()
+	(*p)[1] = 5
+	if s.Contains(2) || s.Index(5) != 1 {
+		t.Fatal("SliceP should mark the index as stale")
+	}
+	// Writes after a lookup are still seen while the pointer is outstanding.
+	(*p)[2] = 6
+	s.Append(7)
+	(*p)[0] = 8
+	if s.Contains(3) || s.Index(6) != 2 || s.Index(8) != 0 || s.Index(7) != 3 || s.IndexSize() != 4 {
+		t.Fatalf("lookups after writes through SliceP = %v", s.S())
+	}
+	s.Reindex()
+	if !slices.Equal(s.Positions(7), []int{3}) || s.IndexSize() != 4 {
+		t.Fatal("Reindex should turn the index back on")
+	}
+
+	c := s.Clone()
+	c.Set(0, 9)
+	if s.Contains(9) || !c.Contains(9) {
+		t.Fatal("clones should have independent indexes")
+	}
+}
+
+func BenchmarkIndex(b *testing.B) {
+	items := make([]int, 100_000)
+	for i := range items {
+		items[i] = i
+	}
+	b.Run("ComparableSlice", func(b *testing.B) {
+		s := slicelib.NewComparableSlice(items...)
+		for i := range b.N {
+			s.Index(i % len(items))
+		}
+	})
+	b.Run("IndexedSlice", func(b *testing.B) {
+		s := slicelib.NewIndexedSlice(items...)
+		for i := range b.N {
+			s.Index(i % len(items))
+		}
+	})
+}
+
+func BenchmarkPopLast(b *testing.B) {
+	// Tail deletes do not shift any position, so they should not depend on
+	// the number of distinct values.
+	items := make([]int, 100_000)
+	for i := range items {
+		items[i] = i
+	}
+	s := slicelib.NewIndexedSlice(items...)
+	b.ResetTimer()
+	for i := range b.N {
+		s.Pop(s.Len() - 1)
+		s.Append(i)
+	}
+}
@@ -0,0 +1,204 @@
+package keyindex_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+type order struct {
+	ID       int
+	Customer string
+	Status   string
+}
+
+func newOrders(s slicelib.Slicer[order]) *slicelib.KeyIndexed[order] {
+	k := slicelib.NewKeyIndexed(s)
+	if err := slicelib.AddUniqueIndex(k, "id", func(o order) int { return o.ID }); err != nil {
+		panic(err)
+	}
+	slicelib.AddIndex(k, "customer", func(o order) string { return o.Customer })
+	slicelib.AddIndex(k, "status", func(o order) string { return o.Status })
+	return k
+}
+
+func TestLookup(t *testing.T) {
+	for name, s := range map[string]slicelib.Slicer[order]{
+		"Slice":      slicelib.NewSlice[order](),
+		"LinkedList": slicelib.NewLinkedList[order](),
+	} {
+		t.Run(name, func(t *testing.T) {
+			k := newOrders(s)
+			err := k.Append(
+				order{1, "ann", "pending"},
+				order{2, "bob", "shipped"},
+				order{3, "ann", "shipped"},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if p := slicelib.Lookup(k, "customer", "ann"); !slices.Equal(p, []int{0, 2}) {
+				t.Errorf("Lookup(customer, ann) = %v", p)
+			}
+			if v := slicelib.LookupValues(k, "id", 2); len(v) != 1 || v[0].Customer != "bob" {
+				t.Errorf("LookupValues(id, 2) = %v", v)
+			}
+
+			k.Pop(0)
+			if p := slicelib.Lookup(k, "status", "shipped"); !slices.Equal(p, []int{0, 1}) {
+				t.Errorf("Lookup(status, shipped) after Pop = %v", p)
+			}
+
+			if err := k.Set(0, order{2, "bob", "delivered"}); err != nil {
+				t.Fatal(err)
+			}
+			if p := slicelib.Lookup(k, "status", "delivered"); !slices.Equal(p, []int{0}) {
+				t.Errorf("Lookup(status, delivered) after Set = %v", p)
+			}
+
+			k.Reverse()
+			if p := slicelib.Lookup(k, "id", 3); !slices.Equal(p, []int{0}) {
+				t.Errorf("Lookup(id, 3) after Reverse = %v", p)
+			}
+		})
+	}
+}
+
+func TestUniqueViolation(t *testing.T) {
+	k := newOrders(slicelib.NewSlice(order{1, "ann", "pending"}, order{2, "bob", "pending"}))
+
+	for name, err := range map[string]error{
+		"Append existing": k.Append(order{ID: 3}, order{ID: 1}),
+		"Append batch":    k.Append(order{ID: 3}, order{ID: 3}),
+		"Insert":          k.Insert(0, order{ID: 2}),
+		"Set":             k.Set(0, order{ID: 2}),
+	} {
+		if !errors.Is(err, slicelib.ErrUniqueViolation) {
+			t.Errorf("%s: err = %v, expected ErrUniqueViolation", name, err)
+		}
+	}
+	if k.Len() != 2 || k.At(0).ID != 1 {
+		t.Fatalf("failed mutations modified the elements: %v", k)
+	}
+
+	// Replacing an element with one that has the same key is allowed.
+	if err := k.Set(0, order{1, "ann", "shipped"}); err != nil {
+		t.Fatal(err)
+	}
+
+	dup := slicelib.NewKeyIndexed[order](slicelib.NewSlice(order{ID: 1}, order{ID: 1}))
+	err := slicelib.AddUniqueIndex(dup, "id", func(o order) int { return o.ID })
+	if !errors.Is(err, slicelib.ErrUniqueViolation) {
+		t.Fatalf("AddUniqueIndex over duplicated keys: err = %v", err)
+	}
+}
+
+func TestReindexViolation(t *testing.T) {
+	s := slicelib.NewSlice(order{ID: 1}, order{ID: 2})
+	k := newOrders(s)
+
+	// Modifying the wrapped Slicer directly breaks the unique index.
+	s.Set(1, order{ID: 1, Status: "pending"})
+	if err := k.Reindex(); !errors.Is(err, slicelib.ErrUniqueViolation) {
+		t.Fatalf("Reindex: err = %v, expected ErrUniqueViolation", err)
+	}
+	if p := slicelib.Lookup(k, "id", 1); !slices.Equal(p, []int{0, 1}) {
+		t.Errorf("Lookup(id, 1) after a violation = %v", p)
+	}
+	if p := slicelib.Lookup(k, "status", "pending"); !slices.Equal(p, []int{1}) {
+		t.Errorf("Lookup(status, pending) after a violation = %v", p)
+	}
+	if err := k.Append(order{ID: 3}); !errors.Is(err, slicelib.ErrUniqueViolation) {
+		t.Errorf("Append over a violated index: err = %v", err)
+	}
+
+	k.Pop(1)
+	if err := k.Reindex(); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.Append(order{ID: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := k.Slicer().(slicelib.Slicer[order]); ok {
+		t.Error("Slicer should not expose the mutating methods")
+	}
+}
+
+func TestUnknownIndex(t *testing.T) {
+	k := newOrders(slicelib.NewSlice[order]())
+	for name, f := range map[string]func(){
+		"name": func() { slicelib.Lookup(k, "missing", 1) },
+		"key":  func() { slicelib.Lookup(k, "id", "1") },
+		"dup":  func() { slicelib.AddIndex(k, "id", func(o order) int { return o.ID }) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestConsistency(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	k := slicelib.NewKeyIndexed[int](slicelib.NewLinkedList[int]())
+	slicelib.AddIndex(k, "mod", func(v int) int { return v % 5 })
+	var ref []int
+
+	for step := range 2000 {
+		switch r.IntN(7) {
+		case 0, 1:
+			items := []int{r.IntN(50), r.IntN(50)}
+			if err := k.Append(items...); err != nil {
+				t.Fatal(err)
+			}
+			ref = append(ref, items...)
+		case 2:
+			i, v := r.IntN(len(ref)+1), r.IntN(50)
+			if err := k.Insert(i, v); err != nil {
+				t.Fatal(err)
+			}
+			ref = slices.Insert(ref, i, v)
+		case 3:
+			j := r.IntN(len(ref) + 1)
+			i := r.IntN(j + 1)
+			k.Delete(i, j)
+			ref = slices.Delete(ref, i, j)
+		case 4:
+			if len(ref) == 0 {
+				continue
+			}
+			i, v := r.IntN(len(ref)), r.IntN(50)
+			if err := k.Set(i, v); err != nil {
+				t.Fatal(err)
+			}
+			ref[i] = v
+		case 5:
+			k.SortFunc(func(a, b int) int { return a - b })
+			slices.Sort(ref)
+		case 6:
+			n := r.IntN(50)
+			k.Filter(func(v int) bool { return v != n })
+			ref = slices.DeleteFunc(ref, func(v int) bool { return v == n })
+		}
+
+		for m := range 5 {
+			var want []int
+			for i, v := range ref {
+				if v%5 == m {
+					want = append(want, i)
+				}
+			}
+			if got := slicelib.Lookup(k, "mod", m); !slices.Equal(got, want) {
+				t.Fatalf("step %d: Lookup(mod, %d) = %v, expected %v", step, m, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package merge_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestPatch(t *testing.T) {
+	r := rand.New(rand.NewPCG(13, 14))
+	for range 300 {
+		a := make([]int, r.IntN(12))
+		for i := range a {
+			a[i] = r.IntN(4)
+		}
+		b := make([]int, r.IntN(12))
+		for i := range b {
+			b[i] = r.IntN(4)
+		}
+		edits := slicelib.Diff[int](slicelib.NewSlice(a...), slicelib.NewSlice(b...))
+
+		for name, s := range map[string]slicelib.Slicer[int]{
+			"Slice":      slicelib.NewSlice(a...),
+			"LinkedList": slicelib.NewLinkedList(a...),
+		} {
+			if err := slicelib.Patch(s, edits); err != nil {
+				t.Fatalf("%s: Patch(%v, Diff(%v, %v)): %v", name, a, a, b, err)
+			}
+			if !s.Equal(b) {
+				t.Fatalf("%s: Patch(%v, Diff(%v, %v)) = %v", name, a, a, b, s)
+			}
+		}
+	}
+}
+
+func TestPatchConflict(t *testing.T) {
+	edits := slicelib.Diff[string](
+		slicelib.NewSlice("a", "b", "c"),
+		slicelib.NewSlice("a", "c", "d"),
+	)
+
+	for _, drifted := range [][]string{
+		{"a", "x", "c"},
+		{"a", "b"},
+		{"a", "b", "c", "e"},
+	} {
+		s := slicelib.NewSlice(drifted...)
+		if err := slicelib.Patch(s, edits); !errors.Is(err, slicelib.ErrPatchConflict) {
+			t.Errorf("Patch(%v): err = %v, expected ErrPatchConflict", drifted, err)
+		}
+		if !s.Equal(drifted) {
+			t.Errorf("failed Patch modified the target: %v", s)
+		}
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	base := slicelib.NewSlice("a", "b", "c", "d", "e")
+
+	tests := []struct {
+		name          string
+		ours, theirs  []string
+		expected      []string
+		conflictCount int
+	}{
+		{
+			name:     "disjoint changes",
+			ours:     []string{"a", "B", "c", "d", "e"},
+			theirs:   []string{"a", "b", "c", "d", "e", "f"},
+			expected: []string{"a", "B", "c", "d", "e", "f"},
+		},
+		{
+			name:     "same change",
+			ours:     []string{"a", "c", "d", "e"},
+			theirs:   []string{"a", "c", "d", "e"},
+			expected: []string{"a", "c", "d", "e"},
+		},
+		{
+			name:     "one side only",
+			ours:     []string{"a", "b", "c", "d", "e"},
+			theirs:   []string{"x", "a", "d", "e"},
+			expected: []string{"x", "a", "d", "e"},
+		},
+		{
+			name:          "conflict",
+			ours:          []string{"a", "b", "X", "d", "e"},
+			theirs:        []string{"a", "b", "Y", "d", "e"},
+			expected:      []string{"a", "b", "<", "X", "=", "Y", ">", "d", "e"},
+			conflictCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		merged, conflicts := slicelib.Merge3[string](
+			base,
+			slicelib.NewLinkedList(tt.ours...),
+			slicelib.NewSlice(tt.theirs...),
+			slicelib.Markers("<", "=", ">"),
+		)
+		if !merged.Equal(tt.expected) {
+			t.Errorf("%s: Merge3 = %v, expected %v", tt.name, merged, tt.expected)
+		}
+		if len(conflicts) != tt.conflictCount {
+			t.Errorf("%s: %d conflicts, expected %d", tt.name, len(conflicts), tt.conflictCount)
+		}
+	}
+}
+
+func TestConflict(t *testing.T) {
+	base := slicelib.NewSlice(1, 2, 3)
+	ours := slicelib.NewSlice(1, 20, 3)
+	theirs := slicelib.NewSlice(1, 200, 3)
+
+	merged, conflicts := slicelib.Merge3[int](base, ours, theirs, slicelib.TakeTheirs[int])
+	if !merged.Equal([]int{1, 200, 3}) {
+		t.Errorf("Merge3 with TakeTheirs = %v", merged)
+	}
+
+	expected := slicelib.Conflict[int]{Base: []int{2}, Ours: []int{20}, Theirs: []int{200}, Position: 1}
+	if len(conflicts) != 1 || !conflictEqual(conflicts[0], expected) {
+		t.Fatalf("conflicts = %v, expected [%v]", conflicts, expected)
+	}
+
+	// A nil resolver keeps our version.
+	merged, _ = slicelib.Merge3[int](base, ours, theirs, nil)
+	if !merged.Equal([]int{1, 20, 3}) {
+		t.Errorf("Merge3 with a nil resolver = %v", merged)
+	}
+}
+
+func conflictEqual(a, b slicelib.Conflict[int]) bool {
+	return slices.Equal(a.Base, b.Base) && slices.Equal(a.Ours, b.Ours) &&
+		slices.Equal(a.Theirs, b.Theirs) && a.Position == b.Position
+}
@@ -0,0 +1,136 @@
+package metrics_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+// isSubsequence reports whether sub can be obtained by removing elements from s.
+func isSubsequence(sub, s []int) bool {
+	i := 0
+	for _, v := range s {
+		if i < len(sub) && sub[i] == v {
+			i++
+		}
+	}
+	return i == len(sub)
+}
+
+// lcsLen computes the length of the LCS with the full dynamic programming table.
+func lcsLen(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func random(r *rand.Rand) []int {
+	s := make([]int, r.IntN(20))
+	for i := range s {
+		s[i] = r.IntN(5)
+	}
+	return s
+}
+
+func TestLCS(t *testing.T) {
+	r := rand.New(rand.NewPCG(15, 16))
+	for range 300 {
+		a, b := random(r), random(r)
+		got := slicelib.NewComparableSlice(a...).LCS(b)
+		if len(got) != lcsLen(a, b) || !isSubsequence(got, a) || !isSubsequence(got, b) {
+			t.Fatalf("LCS(%v, %v) = %v, expected length %d", a, b, got, lcsLen(a, b))
+		}
+	}
+}
+
+func TestDistances(t *testing.T) {
+	tests := []struct {
+		a, b             string
+		levenshtein, osa int
+	}{
+		{"kitten", "sitting", 3, 3},
+		{"", "abc", 3, 3},
+		{"abc", "abc", 0, 0},
+		{"ca", "ac", 2, 1},
+		{"abcdef", "badcfe", 4, 3},
+		// OSA does not allow editing a transposed pair again.
+		{"ca", "abc", 3, 3},
+	}
+	for _, tt := range tests {
+		a := slicelib.NewComparableSlice(strings.Split(tt.a, "")...)
+		b := strings.Split(tt.b, "")
+		if tt.b == "" {
+			b = nil
+		}
+		if tt.a == "" {
+			a = slicelib.NewComparableSlice[string]()
+		}
+
+		if d := a.LevenshteinDistance(b); d != tt.levenshtein {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, expected %d", tt.a, tt.b, d, tt.levenshtein)
+		}
+		if d := a.DamerauLevenshtein(b); d != tt.osa {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, expected %d", tt.a, tt.b, d, tt.osa)
+		}
+	}
+}
+
+func TestJaccardHamming(t *testing.T) {
+	a := slicelib.NewComparableSlice(1, 2, 2, 3)
+	if j := a.JaccardSimilarity([]int{2, 3, 4}); j != 0.5 {
+		t.Errorf("JaccardSimilarity = %v, expected 0.5", j)
+	}
+	if j := slicelib.NewComparableSlice[int]().JaccardSimilarity(nil); j != 1 {
+		t.Errorf("JaccardSimilarity of empty slices = %v, expected 1", j)
+	}
+
+	if d, err := a.HammingDistance([]int{1, 3, 2, 4}); err != nil || d != 2 {
+		t.Errorf("HammingDistance = %d, %v", d, err)
+	}
+	if _, err := a.HammingDistance([]int{1}); !errors.Is(err, slicelib.ErrLengthMismatch) {
+		t.Errorf("HammingDistance of different lengths: err = %v", err)
+	}
+}
+
+func TestFuncVariants(t *testing.T) {
+	a := slicelib.NewLinkedList("The", "quick", "brown", "fox")
+	b := slicelib.NewSlice("the", "QUICK", "red", "fox")
+	eq := strings.EqualFold
+
+	if l := slicelib.LCSFunc[string](a, b, eq); len(l) != 3 {
+		t.Errorf("LCSFunc = %v", l)
+	}
+	if d := slicelib.LevenshteinDistanceFunc[string](a, b, eq); d != 1 {
+		t.Errorf("LevenshteinDistanceFunc = %d", d)
+	}
+	if d := slicelib.DamerauLevenshteinFunc[string](a, b, eq); d != 1 {
+		t.Errorf("DamerauLevenshteinFunc = %d", d)
+	}
+	if j := slicelib.JaccardSimilarityFunc[string](a, b, eq); j != 0.6 {
+		t.Errorf("JaccardSimilarityFunc = %v", j)
+	}
+	if d, err := slicelib.HammingDistanceFunc[string](a, b, eq); err != nil || d != 1 {
+		t.Errorf("HammingDistanceFunc = %d, %v", d, err)
+	}
+
+	// Methods honor the equality strategy of the slice.
+	s := slicelib.NewComparableSlice("Go", "go")
+	s.SetEquality(eq)
+	if j := s.JaccardSimilarity([]string{"GO"}); j != 1 {
+		t.Errorf("JaccardSimilarity with EqualFold = %v", j)
+	}
+}
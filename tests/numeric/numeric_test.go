@@ -0,0 +1,137 @@
+package numeric_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestStatistics(t *testing.T) {
+	s := slicelib.NewNumericSlice(4, 1, 3, 2, 4, 6)
+
+	if s.Min() != 1 || s.Max() != 6 {
+		t.Errorf("Min, Max = %d, %d", s.Min(), s.Max())
+	}
+	if lo, hi := s.MinMax(); lo != 1 || hi != 6 {
+		t.Errorf("MinMax = %d, %d", lo, hi)
+	}
+	if s.Sum() != 20 || s.Product() != 576 {
+		t.Errorf("Sum, Product = %d, %d", s.Sum(), s.Product())
+	}
+	if !near(s.Mean(), 20.0/6) {
+		t.Errorf("Mean = %v", s.Mean())
+	}
+	if s.Median() != 3.5 {
+		t.Errorf("Median = %v", s.Median())
+	}
+	if m := s.Mode(); !slices.Equal(m, []int{4}) {
+		t.Errorf("Mode = %v", m)
+	}
+	// Mean 10/3, squared deviations sum to 46/3.
+	if !near(s.Variance(), 23.0/9) || !near(s.StdDev(), math.Sqrt(23.0/9)) {
+		t.Errorf("Variance, StdDev = %v, %v", s.Variance(), s.StdDev())
+	}
+
+	// Statistics must not reorder the slice.
+	if !s.Equal([]int{4, 1, 3, 2, 4, 6}) {
+		t.Errorf("slice modified: %v", s)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	s := slicelib.NewNumericSlice(10.0, 20, 30, 40)
+	s.Sort()
+
+	tests := []struct {
+		mode     slicelib.Interpolation
+		expected float64
+	}{
+		// The 50th percentile falls at rank 1.5.
+		{slicelib.Linear, 25},
+		{slicelib.Lower, 20},
+		{slicelib.Higher, 30},
+		{slicelib.Nearest, 20},
+		{slicelib.Midpoint, 25},
+	}
+	for _, tt := range tests {
+		if got := s.Percentile(50, tt.mode); got != tt.expected {
+			t.Errorf("Percentile(50, %d) = %v, expected %v", tt.mode, got, tt.expected)
+		}
+	}
+
+	if got := s.Percentile(90, slicelib.Linear); !near(got, 37) {
+		t.Errorf("Percentile(90) = %v", got)
+	}
+	if s.Percentile(0, slicelib.Linear) != 10 || s.Percentile(100, slicelib.Linear) != 40 {
+		t.Error("Percentile(0) and Percentile(100) should be the extremes")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Percentile(101) should panic")
+		}
+	}()
+	s.Percentile(101, slicelib.Linear)
+}
+
+func TestEmpty(t *testing.T) {
+	s := slicelib.NewNumericSlice[float64]()
+	for name, v := range map[string]float64{
+		"Mean":     s.Mean(),
+		"Median":   s.Median(),
+		"Variance": s.Variance(),
+	} {
+		if !math.IsNaN(v) {
+			t.Errorf("%s of an empty slice = %v, expected NaN", name, v)
+		}
+	}
+	if s.Sum() != 0 || s.Product() != 1 || s.Mode() != nil || s.Histogram(3) != nil {
+		t.Error("unexpected result for an empty slice")
+	}
+}
+
+func TestMultimodal(t *testing.T) {
+	s := slicelib.NewNumericSlice[uint8](3, 1, 3, 1, 2)
+	if m := s.Mode(); !slices.Equal(m, []uint8{1, 3}) {
+		t.Errorf("Mode = %v", m)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	s := slicelib.NewNumericSlice(0, 1, 2, 5, 9, 10)
+	h := s.Histogram(2)
+	expected := []slicelib.HistogramBin{
+		{Low: 0, High: 5, Count: 3},
+		{Low: 5, High: 10, Count: 3},
+	}
+	if !slices.Equal(h, expected) {
+		t.Errorf("Histogram(2) = %v, expected %v", h, expected)
+	}
+
+	flat := slicelib.NewNumericSlice(7, 7).Histogram(1)
+	if len(flat) != 1 || flat[0].Count != 2 || flat[0].Low != 6.5 {
+		t.Errorf("Histogram of equal elements = %v", flat)
+	}
+}
+
+func TestHistogramNonFinite(t *testing.T) {
+	s := slicelib.NewNumericSlice(math.NaN(), 0, math.Inf(1), 4, math.Inf(-1), 10)
+	h := s.Histogram(2)
+	expected := []slicelib.HistogramBin{
+		{Low: 0, High: 5, Count: 2},
+		{Low: 5, High: 10, Count: 1},
+	}
+	if !slices.Equal(h, expected) {
+		t.Errorf("Histogram(2) = %v, expected %v", h, expected)
+	}
+
+	if h := slicelib.NewNumericSlice(math.NaN(), math.Inf(1)).Histogram(3); h != nil {
+		t.Errorf("Histogram without finite elements = %v, expected nil", h)
+	}
+}
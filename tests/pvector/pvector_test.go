@@ -0,0 +1,87 @@
+package pvector_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestVersions(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	type version struct {
+		vec      *slicelib.PVector[int]
+		expected []int
+	}
+	versions := []version{{slicelib.NewPVector[int](), nil}}
+
+	for i := 0; i < 1500; i++ {
+		last := versions[len(versions)-1]
+		next := version{expected: slices.Clone(last.expected)}
+
+		switch op := r.IntN(6); {
+		case op < 3 || len(last.expected) == 0:
+			items := make([]int, r.IntN(40))
+			for j := range items {
+				items[j] = r.Int()
+			}
+			next.vec = last.vec.Append(items...)
+			next.expected = append(next.expected, items...)
+		case op < 5:
+			at, v := r.IntN(len(last.expected)), r.Int()
+			next.vec = last.vec.Set(at, v)
+			next.expected[at] = v
+		default:
+			next.vec = last.vec.Pop()
+			next.expected = next.expected[:len(next.expected)-1]
+		}
+		versions = append(versions, next)
+	}
+
+	// Every version must still hold its own contents.
+	for i, v := range versions {
+		if v.vec.Len() != len(v.expected) || !slices.Equal(v.vec.S(), v.expected) {
+			t.Fatalf("version %d: got %d elements, expected %d", i, v.vec.Len(), len(v.expected))
+		}
+		for j := range v.expected {
+			if v.vec.At(j) != v.expected[j] {
+				t.Fatalf("version %d: At(%d) = %d, expected %d", i, j, v.vec.At(j), v.expected[j])
+			}
+		}
+	}
+}
+
+func TestTransient(t *testing.T) {
+	base := slicelib.NewPVector(1, 2, 3)
+
+	tr := base.Transient()
+	for i := 4; i <= 2000; i++ {
+		tr.Append(i)
+	}
+	tr.Set(0, 100)
+	for i := 0; i < 500; i++ {
+		tr.Pop()
+	}
+	vec := tr.Persistent()
+
+	if !base.Slice().Equal([]int{1, 2, 3}) {
+		t.Fatalf("base vector was modified: %v", base)
+	}
+	if vec.Len() != 1500 || vec.At(0) != 100 || vec.At(1499) != 1500 {
+		t.Fatalf("unexpected transient result: len %d, first %d", vec.Len(), vec.At(0))
+	}
+
+	ll := slicelib.NewLinkedList(vec.S()...)
+	if !slicelib.PVectorFrom[int](ll).LinkedList().EqualSlicer(ll) {
+		t.Fatal("round trip through a LinkedList lost elements")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("using a transient after Persistent() should panic")
+		}
+	}()
+	tr.Append(1)
+}
@@ -0,0 +1,207 @@
+package query_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+type product struct {
+	Name  string
+	Dept  string
+	Price int
+}
+
+var products = []product{
+	{Name: "mug", Dept: "kitchen", Price: 12},
+	{Name: "lamp", Dept: "home", Price: 40},
+	{Name: "pan", Dept: "kitchen", Price: 30},
+	{Name: "rug", Dept: "home", Price: 40},
+	{Name: "fork", Dept: "kitchen", Price: 3},
+}
+
+func names(s []product) []string {
+	var n []string
+	for _, p := range s {
+		n = append(n, p.Name)
+	}
+	return n
+}
+
+func TestPipeline(t *testing.T) {
+	s := slicelib.NewSlice(products...)
+	byPrice := slicelib.By(func(p product) int { return p.Price })
+	byName := slicelib.By(func(p product) string { return p.Name })
+
+	q := slicelib.NewQuery[product](s).
+		Where(func(p product) bool { return p.Price > 5 }).
+		OrderBy(byPrice.Reverse()).
+		ThenBy(byName).
+		Skip(1).
+		Take(2)
+
+	if got, expected := names(q.ToSlice().S()), []string{"rug", "pan"}; !slices.Equal(got, expected) {
+		t.Errorf("ToSlice = %q, expected %q", got, expected)
+	}
+	if !slices.Equal(s.S(), products) {
+		t.Errorf("The source was modified: %v", s.S())
+	}
+
+	// The Query reads the source when it runs, not when it is built.
+	s.Append(product{Name: "bed", Dept: "home", Price: 40})
+	if got, expected := names(q.ToLinkedList().S()), []string{"lamp", "rug"}; !slices.Equal(got, expected) {
+		t.Errorf("ToLinkedList = %q, expected %q", got, expected)
+	}
+}
+
+func TestLaziness(t *testing.T) {
+	var calls int
+	q := slicelib.NewQuery[int](slicelib.NewLinkedList(1, 2, 3, 4, 5, 6)).
+		Where(func(v int) bool {
+			calls++
+			return v%2 == 0
+		})
+	if calls != 0 {
+		t.Errorf("Building the Query called the predicate %d times", calls)
+	}
+
+	if v, ok := q.Take(1).First(); !ok || v != 2 || calls != 2 {
+		t.Errorf("First = %d, %t after %d calls, expected 2 after 2 calls", v, ok, calls)
+	}
+	calls = 0
+	if !q.Any(func(v int) bool { return v == 4 }) || calls != 4 {
+		t.Errorf("Any made %d calls, expected 4", calls)
+	}
+}
+
+func TestOrderStable(t *testing.T) {
+	q := slicelib.NewQuery[product](slicelib.NewSlice(products...)).
+		OrderBy(slicelib.By(func(p product) string { return p.Dept }))
+
+	expected := []string{"lamp", "rug", "mug", "pan", "fork"}
+	if got := names(q.ToSlice().S()); !slices.Equal(got, expected) {
+		t.Errorf("OrderBy = %q, expected %q", got, expected)
+	}
+
+	// ThenBy extends a copy, the original ordering is kept.
+	then := q.ThenBy(slicelib.By(func(p product) int { return p.Price }))
+	expected2 := []string{"lamp", "rug", "fork", "mug", "pan"}
+	if got := names(then.ToSlice().S()); !slices.Equal(got, expected2) {
+		t.Errorf("ThenBy = %q, expected %q", got, expected2)
+	}
+	if got := names(q.ToSlice().S()); !slices.Equal(got, expected) {
+		t.Errorf("OrderBy after ThenBy = %q, expected %q", got, expected)
+	}
+}
+
+func TestThenByPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("ThenBy without OrderBy did not panic")
+		}
+	}()
+	slicelib.NewQuery[int](slicelib.NewSlice(1)).ThenBy(func(a, b int) int { return a - b })
+}
+
+func TestTerminals(t *testing.T) {
+	q := slicelib.NewQuery[int](slicelib.NewSlice(3, 1, 3, 2, 1))
+
+	if d := q.Distinct().ToSlice().S(); !slices.Equal(d, []int{3, 1, 2}) {
+		t.Errorf("Distinct = %v", d)
+	}
+	if q.Count() != 5 || q.Skip(10).Count() != 0 || q.Take(0).Count() != 0 {
+		t.Errorf("Count = %d", q.Count())
+	}
+	if v, ok := q.Last(); !ok || v != 1 {
+		t.Errorf("Last = %d, %t", v, ok)
+	}
+	if !q.All(func(v int) bool { return v > 0 }) || q.All(func(v int) bool { return v > 1 }) {
+		t.Error("All gave a wrong result")
+	}
+	if q.Any(func(v int) bool { return v > 3 }) {
+		t.Error("Any gave a wrong result")
+	}
+
+	empty := q.Where(func(int) bool { return false })
+	if _, ok := empty.First(); ok {
+		t.Error("First of an empty Query reported an element")
+	}
+	if _, ok := empty.Last(); ok {
+		t.Error("Last of an empty Query reported an element")
+	}
+	if !empty.All(func(int) bool { return false }) {
+		t.Error("All of an empty Query should be true")
+	}
+
+	var reversed []int
+	q.ReverseRange(func(i, v int) bool {
+		reversed = append(reversed, i*10+v)
+		return true
+	})
+	if !slices.Equal(reversed, []int{41, 32, 23, 11, 3}) {
+		t.Errorf("ReverseRange = %v", reversed)
+	}
+}
+
+func TestDistinctNonComparable(t *testing.T) {
+	q := slicelib.NewQuery[[]int](slicelib.NewSlice([]int{1}, []int{2}, []int{1}))
+	if n := q.Distinct().Count(); n != 2 {
+		t.Errorf("Distinct kept %d slices, expected 2", n)
+	}
+}
+
+func TestSelectAndGroupBy(t *testing.T) {
+	q := slicelib.NewQuery[product](slicelib.NewSlice(products...))
+
+	prices := slicelib.Select(q, func(p product) int { return p.Price }).ToSlice().S()
+	if !slices.Equal(prices, []int{12, 40, 30, 40, 3}) {
+		t.Errorf("Select = %v", prices)
+	}
+
+	groups := slicelib.GroupBy(q, func(p product) string { return p.Dept }).ToSlice().S()
+	if len(groups) != 2 ||
+		groups[0].Key != "kitchen" || !slices.Equal(names(groups[0].Values), []string{"mug", "pan", "fork"}) ||
+		groups[1].Key != "home" || !slices.Equal(names(groups[1].Values), []string{"lamp", "rug"}) {
+		t.Errorf("GroupBy = %+v", groups)
+	}
+
+	totals := slicelib.Select(
+		slicelib.GroupBy(q, func(p product) string { return p.Dept }),
+		func(g slicelib.Group[string, product]) string {
+			var sum int
+			for _, p := range g.Values {
+				sum += p.Price
+			}
+			return fmt.Sprintf("%s=%d", g.Key, sum)
+		},
+	).ToSlice().S()
+	if !slices.Equal(totals, []string{"kitchen=45", "home=80"}) {
+		t.Errorf("Totals = %q", totals)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	type manager struct {
+		Dept, Name string
+	}
+	managers := slicelib.NewLinkedList(
+		manager{Dept: "home", Name: "ana"},
+		manager{Dept: "garden", Name: "bo"},
+		manager{Dept: "home", Name: "cy"},
+	)
+
+	pairs := slicelib.Join(
+		slicelib.NewQuery[product](slicelib.NewSlice(products...)).Where(func(p product) bool { return p.Dept == "home" || p.Name == "mug" }),
+		slicelib.NewQuery[manager](managers),
+		func(p product) string { return p.Dept },
+		func(m manager) string { return m.Dept },
+		func(p product, m manager) string { return p.Name + "/" + m.Name },
+	).ToSlice().S()
+
+	expected := []string{"lamp/ana", "lamp/cy", "rug/ana", "rug/cy"}
+	if !slices.Equal(pairs, expected) {
+		t.Errorf("Join = %q, expected %q", pairs, expected)
+	}
+}
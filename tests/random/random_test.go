@@ -0,0 +1,120 @@
+package random_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func seeded() *rand.Rand {
+	return rand.New(rand.NewPCG(19, 20))
+}
+
+func TestShuffle(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	for name, mk := range map[string]func() slicelib.Slicer[int]{
+		"Slice":      func() slicelib.Slicer[int] { return slicelib.NewSlice(items...) },
+		"LinkedList": func() slicelib.Slicer[int] { return slicelib.NewLinkedList(items...) },
+	} {
+		a, b := mk(), mk()
+		slicelib.Shuffle(a, seeded())
+		slicelib.Shuffle(b, seeded())
+
+		if !a.EqualSlicer(b) {
+			t.Errorf("%s: the same seed gave %v and %v", name, a, b)
+		}
+		sorted := slices.Clone(a.S())
+		slices.Sort(sorted)
+		if !slices.Equal(sorted, items) {
+			t.Errorf("%s: Shuffle is not a permutation: %v", name, a)
+		}
+	}
+}
+
+// TestUniform checks that every element is picked about as often by each function.
+func TestUniform(t *testing.T) {
+	const (
+		n      = 5
+		rounds = 20000
+	)
+	s := slicelib.NewLinkedList(0, 1, 2, 3, 4)
+	r := seeded()
+
+	pickers := map[string]func() []int{
+		"Sample":          func() []int { return slicelib.Sample[int](s, 2, r) },
+		"Choice":          func() []int { return []int{slicelib.Choice[int](s, r)} },
+		"ReservoirSample": func() []int { return slicelib.ReservoirSample[int](s, 2, r) },
+	}
+	for name, pick := range pickers {
+		counts := make([]int, n)
+		var total int
+		for range rounds {
+			picked := pick()
+			seen := make(map[int]bool)
+			for _, v := range picked {
+				if seen[v] {
+					t.Fatalf("%s picked %d twice: %v", name, v, picked)
+				}
+				seen[v] = true
+				counts[v]++
+				total++
+			}
+		}
+		for v, c := range counts {
+			if expected := float64(total) / n; math.Abs(float64(c)-expected) > expected*0.05 {
+				t.Errorf("%s picked %d %d times, expected about %.0f", name, v, c, expected)
+			}
+		}
+	}
+}
+
+func TestSampleSize(t *testing.T) {
+	s := slicelib.NewSlice(1, 2, 3)
+	if got := slicelib.Sample[int](s, 10, seeded()); len(got) != 3 {
+		t.Errorf("Sample(10) of 3 elements = %v", got)
+	}
+	if got := slicelib.ReservoirSample[int](s, 10, seeded()); len(got) != 3 {
+		t.Errorf("ReservoirSample(10) of 3 elements = %v", got)
+	}
+	if got := slicelib.Sample[int](s, 0, seeded()); len(got) != 0 {
+		t.Errorf("Sample(0) = %v", got)
+	}
+	if !s.Equal([]int{1, 2, 3}) {
+		t.Errorf("Sample modified the slice: %v", s)
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	s := slicelib.NewSlice("a", "b", "c")
+	r := seeded()
+
+	counts := make(map[string]int)
+	for range 10000 {
+		v, err := slicelib.WeightedChoice[string](s, []float64{1, 0, 3}, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[v]++
+	}
+	if counts["b"] != 0 || math.Abs(float64(counts["c"])/float64(counts["a"])-3) > 0.3 {
+		t.Errorf("WeightedChoice counts = %v, expected a:c about 1:3 and no b", counts)
+	}
+
+	for _, tt := range []struct {
+		weights []float64
+		err     error
+	}{
+		{[]float64{1, 2}, slicelib.ErrLengthMismatch},
+		{[]float64{1, -1, 1}, slicelib.ErrInvalidWeights},
+		{[]float64{0, 0, 0}, slicelib.ErrInvalidWeights},
+		{[]float64{1, math.NaN(), 1}, slicelib.ErrInvalidWeights},
+	} {
+		if _, err := slicelib.WeightedChoice[string](s, tt.weights, r); !errors.Is(err, tt.err) {
+			t.Errorf("WeightedChoice(%v): err = %v, expected %v", tt.weights, err, tt.err)
+		}
+	}
+}
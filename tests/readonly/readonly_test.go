@@ -0,0 +1,52 @@
+package readonly_test
+
+import (
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestReadOnly(t *testing.T) {
+	s := slicelib.NewSlice(1, 2, 3)
+	ro := slicelib.ReadOnly[int](s)
+
+	if _, ok := ro.(slicelib.Slicer[int]); ok {
+		t.Fatal("a read-only view must not implement Slicer")
+	}
+
+	ro.S()[0] = 100
+	if s.At(0) != 1 {
+		t.Fatalf("writing to S() of a read-only view modified the source: %v", s)
+	}
+
+	s.Append(4)
+	if ro.Len() != 4 || !ro.Contains(4) || !ro.EqualSlicer(s) {
+		t.Fatalf("read-only view does not reflect the source: %v", ro)
+	}
+}
+
+func TestImmutableSlice(t *testing.T) {
+	items := []int{1, 2, 3}
+	im := slicelib.NewImmutableSlice(items...)
+
+	items[0] = 100
+	im.S()[1] = 200
+	if !im.Equal([]int{1, 2, 3}) {
+		t.Fatalf("ImmutableSlice was modified: %v", im)
+	}
+
+	var rs slicelib.ReadSlicer[int] = im
+	if _, ok := rs.(slicelib.Slicer[int]); ok {
+		t.Fatal("ImmutableSlice must not implement Slicer")
+	}
+
+	for _, s := range []slicelib.ReadSlicer[int]{
+		slicelib.NewLinkedList(1, 2, 3),
+		slicelib.NewPVector(1, 2, 3),
+		im.Slice(),
+	} {
+		if !im.EqualSlicer(s) || !s.EqualSlicer(im) {
+			t.Errorf("%v should be equal to %v", s, im)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package seq_test
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func random(r *rand.Rand, n int) []byte {
+	s := make([]byte, r.IntN(n))
+	for i := range s {
+		s[i] = 'a' + byte(r.IntN(3))
+	}
+	return s
+}
+
+// TestAgainstBytes checks every operation against its bytes package counterpart.
+func TestAgainstBytes(t *testing.T) {
+	r := rand.New(rand.NewPCG(17, 18))
+	for range 1000 {
+		b, sub, repl := random(r, 20), random(r, 4), random(r, 3)
+		s := slicelib.NewComparableSlice(b...)
+
+		if got, want := s.IndexSeq(sub), bytes.Index(b, sub); got != want {
+			t.Fatalf("IndexSeq(%q, %q) = %d, expected %d", b, sub, got, want)
+		}
+		if got, want := s.LastIndexSeq(sub), bytes.LastIndex(b, sub); got != want {
+			t.Fatalf("LastIndexSeq(%q, %q) = %d, expected %d", b, sub, got, want)
+		}
+		if got, want := s.CountSeq(sub), bytes.Count(b, sub); got != want {
+			t.Fatalf("CountSeq(%q, %q) = %d, expected %d", b, sub, got, want)
+		}
+		if got, want := s.HasPrefix(sub), bytes.HasPrefix(b, sub); got != want {
+			t.Fatalf("HasPrefix(%q, %q) = %t", b, sub, got)
+		}
+		if got, want := s.HasSuffix(sub), bytes.HasSuffix(b, sub); got != want {
+			t.Fatalf("HasSuffix(%q, %q) = %t", b, sub, got)
+		}
+
+		parts := s.Split(sub)
+		want := bytes.Split(b, sub)
+		if len(parts) != len(want) {
+			t.Fatalf("Split(%q, %q) has %d parts, expected %d", b, sub, len(parts), len(want))
+		}
+		for i, p := range parts {
+			if !bytes.Equal(p.S(), want[i]) {
+				t.Fatalf("Split(%q, %q)[%d] = %q, expected %q", b, sub, i, p.S(), want[i])
+			}
+		}
+
+		c := s.Clone()
+		c.ReplaceAll(sub, repl)
+		if want := bytes.ReplaceAll(b, sub, repl); !bytes.Equal(c.S(), want) {
+			t.Fatalf("ReplaceAll(%q, %q, %q) = %q, expected %q", b, sub, repl, c.S(), want)
+		}
+
+		c = s.Clone()
+		c.TrimPrefix(sub)
+		if want := bytes.TrimPrefix(b, sub); !bytes.Equal(c.S(), want) {
+			t.Fatalf("TrimPrefix(%q, %q) = %q, expected %q", b, sub, c.S(), want)
+		}
+		c = s.Clone()
+		c.TrimSuffix(sub)
+		if want := bytes.TrimSuffix(b, sub); !bytes.Equal(c.S(), want) {
+			t.Fatalf("TrimSuffix(%q, %q) = %q, expected %q", b, sub, c.S(), want)
+		}
+	}
+}
+
+func TestSplitCopies(t *testing.T) {
+	s := slicelib.NewComparableSlice(1, 0, 2, 3)
+	parts := s.Split([]int{0})
+	parts[1].Set(0, 20)
+	parts[0].Append(5)
+	if !s.Equal([]int{1, 0, 2, 3}) {
+		t.Fatalf("modifying a part changed the original: %v", s)
+	}
+}
+
+func TestCustomEquality(t *testing.T) {
+	s := slicelib.NewComparableSlice("GET", "/a", "get", "/b")
+	s.SetEquality(strings.EqualFold)
+	if n := s.CountSeq([]string{"Get"}); n != 2 {
+		t.Errorf("CountSeq with EqualFold = %d", n)
+	}
+	if i := s.LastIndexSeq([]string{"get", "/B"}); i != 2 {
+		t.Errorf("LastIndexSeq with EqualFold = %d", i)
+	}
+}
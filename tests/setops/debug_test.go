@@ -0,0 +1,26 @@
+//go:build slicelib_debug
+// +build slicelib_debug
+
+package setops_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+// fallback reports whether unsorted inputs are sorted instead of rejected.
+const fallback = false
+
+func TestNotSorted(t *testing.T) {
+	sorted := slicelib.NewOrderedSlice(1, 2, 3)
+	unsorted := slicelib.NewOrderedSlice(3, 1, 2)
+
+	if _, err := slicelib.UnionSorted(sorted, unsorted, slicelib.Multiset); !errors.Is(err, slicelib.ErrNotSorted) {
+		t.Errorf("UnionSorted: err = %v, expected ErrNotSorted", err)
+	}
+	if _, err := slicelib.KWayMerge(slicelib.Distinct, sorted, unsorted); !errors.Is(err, slicelib.ErrNotSorted) {
+		t.Errorf("KWayMerge: err = %v, expected ErrNotSorted", err)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !slicelib_debug
+// +build !slicelib_debug
+
+package setops_test
+
+// fallback reports whether unsorted inputs are sorted instead of rejected.
+const fallback = true
@@ -0,0 +1,118 @@
+package setops_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+type setOp func(a, b *slicelib.OrderedSlice[int], mode slicelib.SetMode) (*slicelib.OrderedSlice[int], error)
+
+// reference computes the result of an operation from the element counts.
+func reference(a, b []int, mode slicelib.SetMode, count func(n, m int) int) []int {
+	na, nb := make(map[int]int), make(map[int]int)
+	for _, v := range a {
+		na[v]++
+	}
+	for _, v := range b {
+		nb[v]++
+	}
+
+	var out []int
+	for v := range 10 {
+		n, m := na[v], nb[v]
+		if mode == slicelib.Distinct {
+			n, m = min(n, 1), min(m, 1)
+		}
+		c := count(n, m)
+		if mode == slicelib.Distinct {
+			c = min(c, 1)
+		}
+		for range c {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func random(r *rand.Rand) []int {
+	s := make([]int, r.IntN(12))
+	for i := range s {
+		s[i] = r.IntN(10)
+	}
+	return s
+}
+
+func TestSetOperations(t *testing.T) {
+	ops := []struct {
+		name  string
+		op    setOp
+		count func(n, m int) int
+	}{
+		{"MergeSorted", slicelib.MergeSorted[int], func(n, m int) int { return n + m }},
+		{"UnionSorted", slicelib.UnionSorted[int], func(n, m int) int { return max(n, m) }},
+		{"IntersectSorted", slicelib.IntersectSorted[int], func(n, m int) int { return min(n, m) }},
+		{"DifferenceSorted", slicelib.DifferenceSorted[int], func(n, m int) int { return max(n-m, 0) }},
+	}
+
+	r := rand.New(rand.NewPCG(7, 8))
+	for range 200 {
+		a, b := random(r), random(r)
+		sa := slicelib.NewOrderedSlice(a...)
+		sb := slicelib.NewOrderedSlice(b...)
+		// Half of the inputs are unsorted, exercising the fallback.
+		if !fallback || r.IntN(2) == 0 {
+			sa.Sort()
+			sb.Sort()
+		}
+		before := sa.CloneS()
+
+		for _, tt := range ops {
+			for _, mode := range []slicelib.SetMode{slicelib.Multiset, slicelib.Distinct} {
+				got, err := tt.op(sa, sb, mode)
+				if err != nil {
+					t.Fatalf("%s: %v", tt.name, err)
+				}
+				want := reference(a, b, mode, tt.count)
+				if !got.Equal(want) {
+					t.Fatalf("%s(%v, %v, %d) = %v, expected %v", tt.name, a, b, mode, got, want)
+				}
+			}
+		}
+
+		if !sa.Equal(before) {
+			t.Fatalf("input modified: %v, was %v", sa, before)
+		}
+	}
+}
+
+func TestKWayMerge(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	for range 100 {
+		var (
+			inputs []*slicelib.OrderedSlice[int]
+			all    []int
+		)
+		for range r.IntN(6) {
+			s := random(r)
+			if !fallback {
+				slices.Sort(s)
+			}
+			all = append(all, s...)
+			inputs = append(inputs, slicelib.NewOrderedSlice(s...))
+		}
+		slices.Sort(all)
+
+		got, err := slicelib.KWayMerge(slicelib.Multiset, inputs...)
+		if err != nil || !slices.Equal(got.S(), all) {
+			t.Fatalf("KWayMerge(Multiset) = %v, %v, expected %v", got, err, all)
+		}
+
+		got, err = slicelib.KWayMerge(slicelib.Distinct, inputs...)
+		if want := slices.Compact(all); err != nil || !slices.Equal(got.S(), want) {
+			t.Fatalf("KWayMerge(Distinct) = %v, %v, expected %v", got, err, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package slicer_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/Tom5521/slicelib"
@@ -20,6 +21,36 @@ func Test(t *testing.T) {
 		func(i []int) slicelib.Slicer[int] {
 			return slicelib.NewComparableSlice(i...)
 		},
+		func(i []int) slicelib.Slicer[int] {
+			return slicelib.NewNumericSlice(i...)
+		},
+		func(i []int) slicelib.Slicer[int] {
+			return slicelib.NewUnrolledListSize(2, i...)
+		},
+		func(i []int) slicelib.Slicer[int] {
+			gb := slicelib.NewGapBuffer(i...)
+			gb.MoveGap(len(i) / 2)
+			return gb
+		},
+		func(i []int) slicelib.Slicer[int] {
+			// Test on a clone so that every mutation goes through the copy-on-write path.
+			return slicelib.NewCOWSlice(i...).Clone()
+		},
+		func(i []int) slicelib.Slicer[int] {
+			return slicelib.NewIndexedSlice(i...)
+		},
+		func(i []int) slicelib.Slicer[int] {
+			s := slicelib.NewSlice(-1)
+			s.Append(i...)
+			s.Append(-2)
+			return s.View(1, len(i)+1)
+		},
+		func(i []int) slicelib.Slicer[int] {
+			ll := slicelib.NewLinkedList(-1)
+			ll.Append(i...)
+			ll.Append(-2)
+			return ll.View(1, len(i)+1)
+		},
 	}
 
 	type test struct {
@@ -200,6 +231,59 @@ func Test(t *testing.T) {
 				return s.Equal(tt.expected.([]int))
 			},
 		},
+		{
+			name:     "InsertMiddle",
+			input:    []int{1, 2, 3},
+			input2:   1,
+			input3:   []int{4, 5},
+			expected: []int{1, 4, 5, 2, 3},
+
+			pass: func(s slicelib.Slicer[int], tt test) (pass bool) {
+				s.Insert(tt.input2.(int), tt.input3.([]int)...)
+				return s.Equal(tt.expected.([]int))
+			},
+		},
+		{
+			name:     "DeleteHead",
+			input:    []int{1, 2, 3, 4},
+			input2:   0,
+			input3:   2,
+			expected: []int{3, 4},
+
+			pass: func(s slicelib.Slicer[int], tt test) (pass bool) {
+				s.Delete(tt.input2.(int), tt.input3.(int))
+				return s.Equal(tt.expected.([]int))
+			},
+		},
+		{
+			name:     "ReverseRange",
+			input:    []int{1, 2, 3, 4},
+			expected: []int{2, 3, 4},
+
+			pass: func(s slicelib.Slicer[int], tt test) (pass bool) {
+				s.SliceLeft(1)
+				s.Reverse()
+				var got []int
+				s.ReverseRange(func(_ int, v int) bool {
+					got = append(got, v)
+					return true
+				})
+				return slices.Equal(got, tt.expected.([]int))
+			},
+		},
+		{
+			name:  "SortStableFunc",
+			input: []int{31, 12, 33, 14, 35, 16, 11, 32},
+			input2: func(a, b int) int {
+				return a/10 - b/10
+			},
+			expected: []int{12, 14, 16, 11, 31, 33, 35, 32},
+
+			pass: func(s slicelib.Slicer[int], tt test) (pass bool) {
+				s.SortStableFunc(tt.input2.(func(int, int) int))
+				return s.Equal(tt.expected.([]int))
+			},
+		},
 		{
 			name:  "Filter",
 			input: []int{10, 20, 30, 40, 50, 60, 70, 80, 200, 100, 500},
@@ -0,0 +1,111 @@
+package strings_test
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestNaturalSort(t *testing.T) {
+	s := slicelib.NewStringSlice("file10", "file2", "File1", "file02", "file1b", "file1a", "img", "")
+	s.NaturalSort()
+	expected := []string{"", "File1", "file1a", "file1b", "file2", "file02", "file10", "img"}
+	if !slices.Equal(s.S(), expected) {
+		t.Errorf("NaturalSort = %q, expected %q", s.S(), expected)
+	}
+}
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		sign int
+	}{
+		{"a2", "a10", -1},
+		{"a10", "a2", 1},
+		{"x99y", "x100", -1},
+		{"1.10", "1.9", 1},
+		{"007", "7", 1},
+		{"ABC", "abc", -1},
+		{"abc", "abc", 0},
+		{"a", "a1", -1},
+		{"12345678901234567890", "12345678901234567891", -1},
+	}
+	sign := func(n int) int { return max(min(n, 1), -1) }
+
+	for _, tt := range tests {
+		if r := sign(slicelib.NaturalCompare(tt.a, tt.b)); r != tt.sign {
+			t.Errorf("NaturalCompare(%q, %q) = %d, expected %d", tt.a, tt.b, r, tt.sign)
+		}
+		if r := sign(slicelib.NaturalCompare(tt.b, tt.a)); r != -tt.sign {
+			t.Errorf("NaturalCompare(%q, %q) = %d, expected %d", tt.b, tt.a, r, -tt.sign)
+		}
+	}
+}
+
+func TestSortFold(t *testing.T) {
+	s := slicelib.NewStringSlice("banana", "Apple", "cherry", "apple", "Banana")
+	s.SortFold()
+	expected := []string{"Apple", "apple", "banana", "Banana", "cherry"}
+	if !slices.Equal(s.S(), expected) {
+		t.Errorf("SortFold = %q, expected %q", s.S(), expected)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	s := slicelib.NewStringSlice("  go ", "\trust\n", "zig")
+	s.TrimSpace()
+	if j := s.Join(","); j != "go,rust,zig" {
+		t.Errorf("TrimSpace + Join = %q", j)
+	}
+	s.Map(strings.ToUpper)
+	if j := s.Join(" "); j != "GO RUST ZIG" {
+		t.Errorf("Map = %q", j)
+	}
+	if j := slicelib.NewStringSlice().Join(","); j != "" {
+		t.Errorf("Join of an empty slice = %q", j)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	files := []string{"main.go", "main_test.go", "README.md", "go.mod", "slice.go"}
+
+	s := slicelib.NewStringSlice(files...)
+	s.FilterSuffix(".go")
+	if expected := []string{"main.go", "main_test.go", "slice.go"}; !slices.Equal(s.S(), expected) {
+		t.Errorf("FilterSuffix = %q, expected %q", s.S(), expected)
+	}
+
+	s = slicelib.NewStringSlice(files...)
+	s.FilterPrefix("main")
+	if expected := []string{"main.go", "main_test.go"}; !slices.Equal(s.S(), expected) {
+		t.Errorf("FilterPrefix = %q, expected %q", s.S(), expected)
+	}
+
+	s = slicelib.NewStringSlice(files...)
+	s.FilterRegexp(regexp.MustCompile(`^[a-z]+\.(go|mod)$`))
+	if expected := []string{"main.go", "go.mod", "slice.go"}; !slices.Equal(s.S(), expected) {
+		t.Errorf("FilterRegexp = %q, expected %q", s.S(), expected)
+	}
+}
+
+func TestRemoveDuplicatesFold(t *testing.T) {
+	s := slicelib.NewStringSlice("Go", "go", "Rust", "GO", "rust", "Zig", "Straße", "STRASSE", "straße")
+	s.RemoveDuplicatesFold()
+	// Simple case folding does not map ß to ss.
+	expected := []string{"Go", "Rust", "Zig", "Straße", "STRASSE"}
+	if !slices.Equal(s.S(), expected) {
+		t.Errorf("RemoveDuplicatesFold = %q, expected %q", s.S(), expected)
+	}
+}
+
+func TestClone(t *testing.T) {
+	s := slicelib.NewStringSlice("b", "a")
+	c := s.Clone()
+	c.Sort()
+	if s.At(0) != "b" || c.At(0) != "a" {
+		t.Errorf("Clone shares storage: %q, %q", s.S(), c.S())
+	}
+}
@@ -0,0 +1,96 @@
+package unrolled_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestBlocks(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, size := range []int{1, 2, 3, 8} {
+		ul := slicelib.NewUnrolledListSize[int](size)
+		var expected []int
+
+		for i := 0; i < 2000; i++ {
+			v := r.IntN(100)
+			switch op := r.IntN(5); {
+			case op == 0 || len(expected) == 0:
+				ul.Append(v, v+1)
+				expected = append(expected, v, v+1)
+			case op == 1:
+				at := r.IntN(len(expected) + 1)
+				ul.Insert(at, v, v, v)
+				expected = slices.Insert(expected, at, v, v, v)
+			case op == 2:
+				at := r.IntN(len(expected))
+				ul.Pop(at)
+				expected = slices.Delete(expected, at, at+1)
+			case op == 3:
+				i := r.IntN(len(expected))
+				j := i + r.IntN(len(expected)-i+1)
+				ul.Delete(i, j)
+				expected = slices.Delete(expected, i, j)
+			default:
+				at := r.IntN(len(expected))
+				ul.Set(at, v)
+				expected[at] = v
+			}
+
+			if !ul.Equal(expected) {
+				t.Fatalf("block size %d, step %d: got %v, expected %v", size, i, ul, expected)
+			}
+			for j := range expected {
+				if ul.At(j) != expected[j] {
+					t.Fatalf("block size %d, step %d: At(%d) = %d, expected %d", size, i, j, ul.At(j), expected[j])
+				}
+			}
+		}
+
+		ul.Reverse()
+		slices.Reverse(expected)
+		if !ul.Equal(expected) {
+			t.Fatalf("block size %d: Reverse got %v, expected %v", size, ul, expected)
+		}
+
+		ul.Compact()
+		if !ul.Equal(expected) {
+			t.Fatalf("block size %d: Compact got %v, expected %v", size, ul, expected)
+		}
+	}
+}
+
+// mixed runs a workload of appends, middle insertions, deletions and indexed reads.
+func mixed(b *testing.B, s slicelib.Slicer[int]) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10000; i++ {
+		s.Append(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch i % 4 {
+		case 0:
+			s.Insert(r.IntN(s.Len()), i)
+		case 1:
+			s.Pop(r.IntN(s.Len()))
+		default:
+			_ = s.At(r.IntN(s.Len()))
+		}
+	}
+}
+
+func BenchmarkMixedSlice(b *testing.B) {
+	mixed(b, slicelib.NewSlice[int]())
+}
+
+func BenchmarkMixedLinkedList(b *testing.B) {
+	mixed(b, slicelib.NewLinkedList[int]())
+}
+
+func BenchmarkMixedUnrolledList(b *testing.B) {
+	mixed(b, slicelib.NewUnrolledList[int]())
+}
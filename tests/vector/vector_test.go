@@ -0,0 +1,104 @@
+package vector_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestArithmetic(t *testing.T) {
+	a := slicelib.NewNumericSlice(1.0, 2, 3)
+	b := []float64{4, 5, 6}
+
+	sum, err := a.Add(b)
+	if err != nil || !sum.Equal([]float64{5, 7, 9}) {
+		t.Errorf("Add = %v, %v", sum, err)
+	}
+	diff, err := a.Sub(b)
+	if err != nil || !diff.Equal([]float64{-3, -3, -3}) {
+		t.Errorf("Sub = %v, %v", diff, err)
+	}
+	if dot, err := a.Dot(b); err != nil || dot != 32 {
+		t.Errorf("Dot = %v, %v", dot, err)
+	}
+	if s := a.Scale(2); !s.Equal([]float64{2, 4, 6}) {
+		t.Errorf("Scale = %v", s)
+	}
+
+	// The non in-place variants leave the receiver untouched.
+	if !a.Equal([]float64{1, 2, 3}) {
+		t.Fatalf("receiver modified: %v", a)
+	}
+
+	if err := a.AddInPlace(b); err != nil || !a.Equal([]float64{5, 7, 9}) {
+		t.Errorf("AddInPlace = %v, %v", a, err)
+	}
+	if err := a.SubInPlace(b); err != nil || !a.Equal([]float64{1, 2, 3}) {
+		t.Errorf("SubInPlace = %v, %v", a, err)
+	}
+}
+
+func TestLengthMismatch(t *testing.T) {
+	a := slicelib.NewNumericSlice(1, 2, 3)
+	short := []int{1, 2}
+
+	if _, err := a.Add(short); !errors.Is(err, slicelib.ErrLengthMismatch) {
+		t.Errorf("Add: err = %v", err)
+	}
+	if _, err := a.Dot(short); !errors.Is(err, slicelib.ErrLengthMismatch) {
+		t.Errorf("Dot: err = %v", err)
+	}
+	if err := a.SubInPlace(short); !errors.Is(err, slicelib.ErrLengthMismatch) {
+		t.Errorf("SubInPlace: err = %v", err)
+	}
+	if !a.Equal([]int{1, 2, 3}) {
+		t.Errorf("failed operation modified the slice: %v", a)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	a := slicelib.NewNumericSlice(3, 1, 4, 1, 5)
+
+	if c := a.CumSum(); !c.Equal([]int{3, 4, 8, 9, 14}) {
+		t.Errorf("CumSum = %v", c)
+	}
+	if d := a.Diff(); !d.Equal([]int{-2, 3, -3, 4}) {
+		t.Errorf("Diff = %v", d)
+	}
+	if d := slicelib.NewNumericSlice[int]().Diff(); !d.IsEmpty() {
+		t.Errorf("Diff of an empty slice = %v", d)
+	}
+	if c := a.Clamp(2, 4); !c.Equal([]int{3, 2, 4, 2, 4}) {
+		t.Errorf("Clamp = %v", c)
+	}
+	if c := a.Apply(func(v int) int { return v * v }); !c.Equal([]int{9, 1, 16, 1, 25}) {
+		t.Errorf("Apply = %v", c)
+	}
+
+	a.DiffInPlace()
+	if !a.Equal([]int{-2, 3, -3, 4}) {
+		t.Errorf("DiffInPlace = %v", a)
+	}
+	a.CumSumInPlace()
+	if !a.Equal([]int{-2, 1, -2, 2}) {
+		t.Errorf("CumSumInPlace = %v", a)
+	}
+}
+
+func TestNorm(t *testing.T) {
+	v := slicelib.NewNumericSlice(3, 4)
+	if v.Norm() != 5 {
+		t.Errorf("Norm = %v", v.Norm())
+	}
+
+	u := v.Normalize()
+	if !u.Equal([]float64{0.6, 0.8}) || math.Abs(u.Norm()-1) > 1e-12 {
+		t.Errorf("Normalize = %v", u)
+	}
+
+	if z := slicelib.NewNumericSlice(0, 0).Normalize(); !z.Equal([]float64{0, 0}) {
+		t.Errorf("Normalize of a zero vector = %v", z)
+	}
+}
@@ -0,0 +1,56 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/Tom5521/slicelib"
+)
+
+func TestView(t *testing.T) {
+	parents := map[string]func(...int) slicelib.Slicer[int]{
+		"Slice": func(i ...int) slicelib.Slicer[int] {
+			return slicelib.NewSlice(i...)
+		},
+		"LinkedList": func(i ...int) slicelib.Slicer[int] {
+			return slicelib.NewLinkedList(i...)
+		},
+	}
+
+	for name, maker := range parents {
+		parent := maker(1, 2, 3, 4, 5, 6)
+		var v slicelib.Slicer[int]
+		switch p := parent.(type) {
+		case *slicelib.Slice[int]:
+			v = p.View(1, 4)
+		case *slicelib.LinkedList[int]:
+			v = p.View(1, 4)
+		}
+
+		v.Set(0, 20)
+		v.SortFunc(func(a, b int) int { return b - a })
+		if !v.Equal([]int{20, 4, 3}) || !parent.Equal([]int{1, 20, 4, 3, 5, 6}) {
+			t.Fatalf("%s: writes did not go through: view %v, parent %v", name, v, parent)
+		}
+
+		v.Append(7)
+		v.Pop(0)
+		if !v.Equal([]int{4, 3, 7}) || !parent.Equal([]int{1, 4, 3, 7, 5, 6}) {
+			t.Fatalf("%s: structural changes did not go through: view %v, parent %v", name, v, parent)
+		}
+
+		// The window is bound to positions, so it follows the parent's changes.
+		parent.Pop(0)
+		if !v.Equal([]int{3, 7, 5}) {
+			t.Fatalf("%s: view did not follow the parent: %v", name, v)
+		}
+		parent.SliceRight(2)
+		if v.Len() != 1 || !v.Equal([]int{3}) {
+			t.Fatalf("%s: view was not clamped to the parent: %v", name, v)
+		}
+
+		v.Clear()
+		if !parent.Equal([]int{4}) {
+			t.Fatalf("%s: Clear did not remove the window from the parent: %v", name, parent)
+		}
+	}
+}
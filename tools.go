@@ -5,26 +5,6 @@ import (
 	"reflect"
 )
 
-// deepEqual creates a deep comparison function for a specific value
-// Uses reflect.DeepEqual to compare complex structures and types
-// T is a generic type that can be of any type
-// Returns a function that compares a value with the original value.
-func deepEqual[T any](t1 T) func(T) bool {
-	return func(t T) bool {
-		return reflect.DeepEqual(t1, t)
-	}
-}
-
-// comparableEqual creates a simple comparison function for a specific value
-// Uses the == comparison operator for comparable types
-// T is a generic type that can be of any type
-// Returns a function that compares a value with the original value.
-func comparableEqual[T any](t1 T) func(T) bool {
-	return func(t T) bool {
-		return any(t1) == any(t)
-	}
-}
-
 // deepEqual2 compares two values using a deep comparison
 // Uses reflect.DeepEqual to compare complex structures and types
 // T is a generic type that can be of any type
@@ -68,14 +48,14 @@ func outOfRangePanic(i, l int) {
 // s1 and s2 are the slices to compare
 // f is the custom comparison function
 // Returns true if the slices are considered equal according to the comparison function.
-func equalSlicersFunc[T any](s1, s2 Slicer[T], f func(T, T) bool) (eq bool) {
+func equalSlicersFunc[T any](s1, s2 ReadSlicer[T], f func(T, T) bool) (eq bool) {
 	// Check that the slices have the same length
 	if s1.Len() != s2.Len() {
 		return false
 	}
 
 	// Interface for types that can return a slice pointer
-	type pointerer interface{ SliceP() *[]T }
+	type pointerer interface{ sliceP() *[]T }
 
 	// Check if both slices can provide a pointer
 	_, s1CanPointer := s1.(pointerer)
@@ -83,21 +63,18 @@ func equalSlicersFunc[T any](s1, s2 Slicer[T], f func(T, T) bool) (eq bool) {
 
 	// Optimization for pointer comparison
 	if s1CanPointer && s2CanPointer {
-		ptr1 := s1.(pointerer).SliceP()
-		ptr2 := s2.(pointerer).SliceP()
+		ptr1 := s1.(pointerer).sliceP()
+		ptr2 := s2.(pointerer).sliceP()
 
 		// If pointers are identical, slices are equal
 		if ptr1 == ptr2 {
 			return true
 		}
-
-		// Check the capacity of the slices
-		if cap(*ptr1) != cap(*ptr2) {
-			return false
-		}
 	}
 
 	// Compare elements using the provided function
+	// Capacity is ignored and empty slicers are equal, as in slices.EqualFunc
+	eq = true
 	s1.Range(func(i int, t T) bool {
 		eq = f(t, s2.At(i))
 		return eq
@@ -105,3 +82,122 @@ func equalSlicersFunc[T any](s1, s2 Slicer[T], f func(T, T) bool) (eq bool) {
 
 	return
 }
+
+// hasEqualMethod reports whether T implements Equaler[T].
+func hasEqualMethod[T any]() bool {
+	return reflect.TypeFor[T]().Implements(reflect.TypeFor[Equaler[T]]())
+}
+
+// equalerEqual2 compares two values using their Equal method
+// T must implement Equaler[T]
+// Returns true if the values are equal, false otherwise.
+func equalerEqual2[T any](t1, t2 T) bool {
+	return any(t1).(Equaler[T]).Equal(t2)
+}
+
+// interfaceEqual2 compares two values of a type holding interfaces
+// Uses the == operator when the dynamic values are comparable
+// and reflect.DeepEqual otherwise, so it never panics
+// Returns true if the values are equal, false otherwise.
+func interfaceEqual2[T any](t1, t2 T) bool {
+	if reflect.ValueOf(t1).Comparable() && reflect.ValueOf(t2).Comparable() {
+		return any(t1) == any(t2)
+	}
+	return reflect.DeepEqual(t1, t2)
+}
+
+// equalFunc returns the default comparison strategy for T
+// Uses the Equal method of types implementing Equaler, the == operator
+// for comparable types and reflect.DeepEqual otherwise
+// T is a generic type that can be of any type
+// Returns a function that reports whether two values are equal.
+func equalFunc[T any]() func(T, T) bool {
+	switch {
+	case hasEqualMethod[T]():
+		return equalerEqual2[T]
+	case holdsInterface(reflect.TypeFor[T]()):
+		return interfaceEqual2[T]
+	case reflect.TypeFor[T]().Comparable():
+		return comparableEqual2[T]
+	}
+	return deepEqual2[T]
+}
+
+// holdsInterface reports whether typ is an interface, or a struct or array holding one.
+// Such types are comparable, but comparing or hashing them panics
+// when an interface holds a non-comparable value such as a slice.
+func holdsInterface(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return holdsInterface(typ.Elem())
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			if holdsInterface(typ.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// strategyOr returns eq, or the default comparison strategy for T if eq is nil.
+func strategyOr[T any](eq EqualityStrategy[T]) func(T, T) bool {
+	if eq != nil {
+		return eq
+	}
+	return equalFunc[T]()
+}
+
+// uniqueFunc creates a stateful predicate that only accepts
+// the first occurrence of each value, to be used with Filter
+// eq is the equality used, nil selects the default strategy
+// Hashes the values when the default strategy is the == operator,
+// otherwise compares each value with the ones already seen.
+// Types holding interfaces are hashed only when the dynamic values are comparable,
+// so that slices, maps and structs holding them never reach the map.
+func uniqueFunc[T any](eq EqualityStrategy[T]) func(T) bool {
+	f := strategyOr(eq)
+	var seen []T
+	linear := func(t T) bool {
+		for _, s := range seen {
+			if f(s, t) {
+				return false
+			}
+		}
+		seen = append(seen, t)
+		return true
+	}
+
+	typ := reflect.TypeFor[T]()
+	if eq != nil || hasEqualMethod[T]() || !typ.Comparable() {
+		return linear
+	}
+
+	hashed := make(map[any]bool)
+	dynamic := holdsInterface(typ)
+	return func(t T) bool {
+		if dynamic && !reflect.ValueOf(t).Comparable() {
+			return linear(t)
+		}
+		if hashed[t] {
+			return false
+		}
+		hashed[t] = true
+		return true
+	}
+}
+
+// equalityHolder is implemented by the containers that accept an EqualityStrategy.
+type equalityHolder[T any] interface {
+	equality() EqualityStrategy[T]
+}
+
+// equalityOf returns the EqualityStrategy set on s, or nil if it uses the default one.
+func equalityOf[T any](s any) EqualityStrategy[T] {
+	if h, ok := s.(equalityHolder[T]); ok {
+		return h.equality()
+	}
+	return nil
+}
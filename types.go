@@ -1,34 +1,102 @@
 package slicelib
 
-type Slicer[T any] interface {
+// Equaler is implemented by element types with their own notion of equality,
+// such as time.Time. When an element type implements it, its Equal method is
+// used instead of the == operator or reflect.DeepEqual.
+type Equaler[T any] interface {
+	Equal(T) bool
+}
+
+// EqualityStrategy reports whether two elements are equal.
+// Containers can be given one with SetEquality to override the default comparison
+// in Index, LastIndex, Contains, Remove, RemoveLast, RemoveDuplicates and Equal.
+type EqualityStrategy[T any] func(a, b T) bool
+
+// Indexer provides positional read access to a sequence.
+type Indexer[T any] interface {
 	At(int) T
-	S() []T
-	Append(...T)
 	Len() int
-	Remove(T)
-	RemoveLast(T)
-	Pop(int)
-	Delete(int, int)
-	String() string
+	IsEmpty() bool
+	InRange(int) bool
+}
+
+// Ranger iterates over a sequence in both directions.
+// The callbacks receive (index, value) and stop the iteration by returning false.
+type Ranger[T any] interface {
 	Range(func(int, T) bool)
 	ReverseRange(func(int, T) bool)
+}
+
+// Searcher finds values in a sequence.
+type Searcher[T any] interface {
 	Index(T) int
 	LastIndex(T) int
 	Contains(T) bool
-	Clear()
-	Insert(int, ...T)
-	Reverse()
-	IsEmpty() bool
-	RemoveDuplicates()
+}
+
+// Comparer compares a sequence with slices and other sequences.
+type Comparer[T any] interface {
 	Equal([]T) bool
-	EqualSlicer(Slicer[T]) bool
+	EqualSlicer(ReadSlicer[T]) bool
 	EqualFunc([]T, func(T, T) bool) bool
-	EqualSlicerFunc(Slicer[T], func(T, T) bool) bool
-	SortFunc(func(T, T) int)
+	EqualSlicerFunc(ReadSlicer[T], func(T, T) bool) bool
+}
+
+// Setter replaces elements in place.
+type Setter[T any] interface {
+	Set(int, T)
+}
+
+// Appender adds elements to a sequence.
+type Appender[T any] interface {
+	Append(...T)
+	Insert(int, ...T)
+}
+
+// Remover removes elements from a sequence.
+type Remover[T any] interface {
+	Remove(T)
+	RemoveLast(T)
+	Pop(int)
+	Delete(int, int)
+	Clear()
+	RemoveDuplicates()
+	Filter(func(T) bool)
+}
+
+// Reslicer narrows a sequence like the slice expressions do.
+type Reslicer[T any] interface {
 	SliceRight(int)      // [:x]
 	SliceLeft(int)       // [x:]
 	SliceRange(int, int) // [x:y]
-	Set(int, T)
-	InRange(int) bool
-	Filter(func(T) bool)
+}
+
+// Sorter reorders the elements of a sequence.
+type Sorter[T any] interface {
+	SortFunc(func(T, T) int)
+	SortStableFunc(func(T, T) int)
+	Reverse()
+}
+
+// ReadSlicer is the read-only subset of Slicer.
+// Every type of the package satisfies it, so functions that only need to
+// inspect a sequence should accept a ReadSlicer (or a narrower capability).
+type ReadSlicer[T any] interface {
+	Indexer[T]
+	Ranger[T]
+	Searcher[T]
+	Comparer[T]
+	S() []T
+	String() string
+}
+
+// Slicer is the union of every capability interface.
+// It is implemented by all the mutable sequences of the package.
+type Slicer[T any] interface {
+	ReadSlicer[T]
+	Setter[T]
+	Appender[T]
+	Remover[T]
+	Reslicer[T]
+	Sorter[T]
 }
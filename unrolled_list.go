@@ -0,0 +1,479 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// DefaultBlockSize is the number of elements held by each block of an
+// UnrolledList created with NewUnrolledList.
+const DefaultBlockSize = 64
+
+// block represents an individual node of the UnrolledList.
+// It stores up to blockSize contiguous elements and links to its neighbours.
+type block[T any] struct {
+	items          []T
+	previous, next *block[T]
+}
+
+// UnrolledList is a generic doubly-linked list whose nodes hold small arrays of elements.
+// It combines the cheap middle insertions and deletions of a LinkedList with the
+// memory locality of a Slice, and indexed access skips whole blocks at a time.
+type UnrolledList[T any] struct {
+	head      *block[T] // First block of the list
+	tail      *block[T] // Last block of the list
+	len       int       // Total number of elements in the list
+	blockSize int       // Maximum number of elements per block
+
+	eq EqualityStrategy[T] // Custom equality, nil for the default one
+}
+
+// NewUnrolledList creates a new UnrolledList using DefaultBlockSize
+// with optional initial elements.
+//
+// Example:
+//
+//	list := NewUnrolledList(1, 2, 3)
+func NewUnrolledList[T any](slice ...T) *UnrolledList[T] {
+	return NewUnrolledListSize(DefaultBlockSize, slice...)
+}
+
+// NewUnrolledListSize creates a new UnrolledList whose blocks hold
+// at most blockSize elements.
+// Panics if blockSize is lower than 1.
+//
+// Example:
+//
+//	list := NewUnrolledListSize(16, "a", "b", "c")
+func NewUnrolledListSize[T any](blockSize int, slice ...T) *UnrolledList[T] {
+	if blockSize < 1 {
+		panic("slicelib: block size must be greater than zero")
+	}
+	ul := &UnrolledList[T]{blockSize: blockSize}
+	ul.Append(slice...)
+
+	return ul
+}
+
+// newBlock allocates an empty block with room for blockSize elements.
+func (ul *UnrolledList[T]) newBlock() *block[T] {
+	return &block[T]{items: make([]T, 0, ul.blockSize)}
+}
+
+// linkAfter inserts the block n right after b.
+// If b is nil, n becomes the new head.
+func (ul *UnrolledList[T]) linkAfter(b, n *block[T]) {
+	n.previous = b
+	if b == nil {
+		n.next = ul.head
+		ul.head = n
+	} else {
+		n.next = b.next
+		b.next = n
+	}
+
+	if n.next != nil {
+		n.next.previous = n
+	} else {
+		ul.tail = n
+	}
+}
+
+// unlink removes the block b from the chain.
+func (ul *UnrolledList[T]) unlink(b *block[T]) {
+	if b.previous != nil {
+		b.previous.next = b.next
+	} else {
+		ul.head = b.next
+	}
+	if b.next != nil {
+		b.next.previous = b.previous
+	} else {
+		ul.tail = b.previous
+	}
+}
+
+// locate finds the block holding the element at index i and
+// the offset of the element inside that block.
+// Panics if the index is out of range.
+func (ul *UnrolledList[T]) locate(i int) (b *block[T], off int) {
+	if !ul.InRange(i) {
+		outOfRangePanic(i, ul.len)
+	}
+
+	if i > ul.len/2 {
+		off = ul.len
+		for b = ul.tail; ; b = b.previous {
+			off -= len(b.items)
+			if i >= off {
+				return b, i - off
+			}
+		}
+	}
+
+	for b = ul.head; ; b = b.next {
+		if i < len(b.items) {
+			return b, i
+		}
+		i -= len(b.items)
+	}
+}
+
+// SetEquality sets the strategy used to compare elements in Index, LastIndex,
+// Contains, Remove, RemoveLast, RemoveDuplicates and Equal.
+// A nil strategy restores the default comparison.
+func (ul *UnrolledList[T]) SetEquality(eq EqualityStrategy[T]) {
+	ul.eq = eq
+}
+
+// equality returns the EqualityStrategy set on the UnrolledList, if any.
+func (ul *UnrolledList[T]) equality() EqualityStrategy[T] {
+	return ul.eq
+}
+
+// equal returns the comparison strategy of the list.
+func (ul *UnrolledList[T]) equal() func(T, T) bool {
+	return strategyOr(ul.eq)
+}
+
+// BlockSize returns the maximum number of elements held by each block.
+func (ul *UnrolledList[T]) BlockSize() int {
+	return ul.blockSize
+}
+
+// Range iterates through the list, allowing operations on each element.
+// The function receives (index, value) and can stop iteration by returning false.
+func (ul *UnrolledList[T]) Range(f func(int, T) bool) {
+	var i int
+	for b := ul.head; b != nil; b = b.next {
+		for _, v := range b.items {
+			if !f(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// ReverseRange iterates through the list from the last element to the first.
+// The function receives (index, value) and can stop iteration by returning false.
+func (ul *UnrolledList[T]) ReverseRange(f func(int, T) bool) {
+	i := ul.len - 1
+	for b := ul.tail; b != nil; b = b.previous {
+		for j := len(b.items) - 1; j >= 0; j-- {
+			if !f(i, b.items[j]) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// At retrieves the element at the specified index.
+// Panics if the index is out of range.
+func (ul *UnrolledList[T]) At(i int) T {
+	b, off := ul.locate(i)
+	return b.items[off]
+}
+
+// Set replaces the element at the specified index.
+// Panics if the index is out of range.
+func (ul *UnrolledList[T]) Set(i int, v T) {
+	b, off := ul.locate(i)
+	b.items[off] = v
+}
+
+// S converts the UnrolledList to a standard Go slice.
+// Useful for interoperability with slice-based functions.
+func (ul *UnrolledList[T]) S() []T {
+	slice := make([]T, 0, ul.len)
+	for b := ul.head; b != nil; b = b.next {
+		slice = append(slice, b.items...)
+	}
+	return slice
+}
+
+// Append adds one or more elements to the end of the list.
+// Fills the last block before allocating new ones.
+func (ul *UnrolledList[T]) Append(items ...T) {
+	ul.len += len(items)
+	for len(items) > 0 {
+		if ul.tail == nil || len(ul.tail.items) == ul.blockSize {
+			ul.linkAfter(ul.tail, ul.newBlock())
+		}
+
+		n := min(ul.blockSize-len(ul.tail.items), len(items))
+		ul.tail.items = append(ul.tail.items, items[:n]...)
+		items = items[n:]
+	}
+}
+
+// Insert adds one or more elements at the specified index.
+// Splits the affected block when it overflows.
+//
+// Panics if the index is out of range.
+func (ul *UnrolledList[T]) Insert(i int, items ...T) {
+	if i == ul.len {
+		ul.Append(items...)
+		return
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	b, off := ul.locate(i)
+	ul.len += len(items)
+	if len(b.items)+len(items) <= ul.blockSize {
+		b.items = slices.Insert(b.items, off, items...)
+		return
+	}
+
+	// The block overflows, so spread its contents into half-filled blocks
+	// leaving room for future insertions.
+	merged := slices.Concat(b.items[:off], items, b.items[off:])
+	half := max(ul.blockSize/2, 1)
+
+	b.items = append(b.items[:0], merged[:half]...)
+	merged = merged[half:]
+	for prev := b; len(merged) > 0; {
+		n := ul.newBlock()
+		size := min(half, len(merged))
+		n.items = append(n.items, merged[:size]...)
+		merged = merged[size:]
+
+		ul.linkAfter(prev, n)
+		prev = n
+	}
+}
+
+// Delete removes elements between indices i and j.
+// Follows the behavior of slices.Delete.
+//
+// Panics if the range is out of bounds.
+func (ul *UnrolledList[T]) Delete(i, j int) {
+	if i < 0 || j > ul.len || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+	if i == j {
+		return
+	}
+
+	b, off := ul.locate(i)
+	for remaining := j - i; remaining > 0; off = 0 {
+		n := min(remaining, len(b.items)-off)
+		b.items = slices.Delete(b.items, off, off+n)
+		remaining -= n
+
+		next := b.next
+		if len(b.items) == 0 {
+			ul.unlink(b)
+		}
+		b = next
+	}
+
+	ul.len -= j - i
+}
+
+// Pop removes the element at the specified index.
+// Panics if the index is out of range.
+func (ul *UnrolledList[T]) Pop(i int) {
+	if !ul.InRange(i) {
+		outOfRangePanic(i, ul.len)
+	}
+	ul.Delete(i, i+1)
+}
+
+// Len returns the number of elements in the list.
+func (ul *UnrolledList[T]) Len() int {
+	return ul.len
+}
+
+// InRange checks if the given index is within the list's bounds.
+func (ul *UnrolledList[T]) InRange(i int) bool {
+	return i >= 0 && i < ul.len
+}
+
+// IsEmpty checks if the list contains no elements.
+func (ul *UnrolledList[T]) IsEmpty() bool {
+	return ul.len == 0
+}
+
+// Clear removes all elements from the list.
+func (ul *UnrolledList[T]) Clear() {
+	ul.head = nil
+	ul.tail = nil
+	ul.len = 0
+}
+
+// Index finds the first occurrence of a value in the list.
+// Uses the strategy set with SetEquality, or the default comparison for the type.
+// Returns -1 if the value is not found.
+func (ul *UnrolledList[T]) Index(val T) (index int) {
+	index = -1
+	eq := ul.equal()
+	ul.Range(func(i int, t T) bool {
+		if eq(val, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// LastIndex finds the last occurrence of a value in the list.
+// Returns -1 if the value is not found.
+func (ul *UnrolledList[T]) LastIndex(val T) (index int) {
+	index = -1
+	eq := ul.equal()
+	ul.ReverseRange(func(i int, t T) bool {
+		if eq(val, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// Contains checks if the list includes a specific value.
+func (ul *UnrolledList[T]) Contains(v T) bool {
+	return ul.Index(v) != -1
+}
+
+// Remove finds and removes the first occurrence of a value.
+func (ul *UnrolledList[T]) Remove(val T) {
+	ul.Pop(ul.Index(val))
+}
+
+// RemoveLast finds and removes the last occurrence of a value.
+func (ul *UnrolledList[T]) RemoveLast(val T) {
+	ul.Pop(ul.LastIndex(val))
+}
+
+// Filter removes elements that do not match the provided predicate function.
+// Works block by block, dropping the blocks that become empty.
+func (ul *UnrolledList[T]) Filter(f func(T) (pass bool)) {
+	for b := ul.head; b != nil; {
+		kept := b.items[:0]
+		for _, v := range b.items {
+			if f(v) {
+				kept = append(kept, v)
+			}
+		}
+		clear(b.items[len(kept):])
+		ul.len -= len(b.items) - len(kept)
+		b.items = kept
+
+		next := b.next
+		if len(b.items) == 0 {
+			ul.unlink(b)
+		}
+		b = next
+	}
+}
+
+// RemoveDuplicates eliminates duplicate elements, keeping only unique values.
+// Preserves the order of first occurrences.
+func (ul *UnrolledList[T]) RemoveDuplicates() {
+	ul.Filter(uniqueFunc(ul.eq))
+}
+
+// Compact repacks the elements so that every block except the last one is full.
+// Useful after many deletions have left the blocks sparsely filled.
+func (ul *UnrolledList[T]) Compact() {
+	slice := ul.S()
+	ul.Clear()
+	ul.Append(slice...)
+}
+
+// Reverse changes the order of elements in the list to their reverse.
+func (ul *UnrolledList[T]) Reverse() {
+	for b := ul.head; b != nil; b = b.previous {
+		b.next, b.previous = b.previous, b.next
+		slices.Reverse(b.items)
+	}
+	ul.head, ul.tail = ul.tail, ul.head
+}
+
+// SortFunc allows custom sorting using a comparison function.
+func (ul *UnrolledList[T]) SortFunc(cmp func(a, b T) int) {
+	slice := ul.S()
+	slices.SortFunc(slice, cmp)
+	ul.Clear()
+	ul.Append(slice...)
+}
+
+// SortStableFunc sorts the list with a comparison function,
+// keeping the original order of equal elements.
+func (ul *UnrolledList[T]) SortStableFunc(cmp func(a, b T) int) {
+	slice := ul.S()
+	slices.SortStableFunc(slice, cmp)
+	ul.Clear()
+	ul.Append(slice...)
+}
+
+// SliceRight is equal to slice[:i].
+func (ul *UnrolledList[T]) SliceRight(i int) {
+	ul.Delete(i, ul.len)
+}
+
+// SliceLeft is equal to slice[i:].
+func (ul *UnrolledList[T]) SliceLeft(i int) {
+	ul.Delete(0, i)
+}
+
+// SliceRange is equal to slice[i:j].
+func (ul *UnrolledList[T]) SliceRange(i, j int) {
+	ul.SliceRight(j)
+	ul.SliceLeft(i)
+}
+
+// Equal compares the list with a slice for equality.
+// Uses deep or standard comparison based on type comparability.
+func (ul *UnrolledList[T]) Equal(s []T) bool {
+	return ul.EqualFunc(s, ul.equal())
+}
+
+// EqualFunc allows custom comparison of the list with a slice.
+func (ul *UnrolledList[T]) EqualFunc(s []T, f func(T, T) bool) (eq bool) {
+	if len(s) != ul.len {
+		return false
+	}
+
+	eq = true
+	ul.Range(func(i int, t T) bool {
+		eq = f(s[i], t)
+		return eq
+	})
+
+	return
+}
+
+// EqualSlicerFunc compares the list with another Slicer using a custom function.
+func (ul *UnrolledList[T]) EqualSlicerFunc(s ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(ul, s, f)
+}
+
+// EqualSlicer compares the list with another Slicer.
+// Uses the strategy set with SetEquality, or the default comparison for the type.
+func (ul *UnrolledList[T]) EqualSlicer(s ReadSlicer[T]) bool {
+	return ul.EqualSlicerFunc(s, ul.equal())
+}
+
+// String provides a string representation of the list.
+func (ul *UnrolledList[T]) String() string {
+	return makeString(ul.Range, ul.Len())
+}
+
+// Clone creates a copy of the list with the same block size.
+func (ul *UnrolledList[T]) Clone() *UnrolledList[T] {
+	n := &UnrolledList[T]{blockSize: ul.blockSize, eq: ul.eq}
+	for b := ul.head; b != nil; b = b.next {
+		nb := n.newBlock()
+		nb.items = append(nb.items, b.items...)
+		n.linkAfter(n.tail, nb)
+	}
+	n.len = ul.len
+
+	return n
+}
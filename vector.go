@@ -0,0 +1,185 @@
+package slicelib
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrLengthMismatch is returned by the operations that need operands
+// of the same length, such as NumericSlice.Add or HammingDistance.
+var ErrLengthMismatch = errors.New("slicelib: length mismatch")
+
+// checkLen returns ErrLengthMismatch if v does not have as many elements as s.
+func (s NumericSlice[T]) checkLen(v []T) error {
+	if len(v) != len(s.slice) {
+		return fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(s.slice), len(v))
+	}
+	return nil
+}
+
+// withSlice wraps an already allocated slice, without copying it.
+func withSlice[T Number](slice []T) *NumericSlice[T] {
+	return &NumericSlice[T]{&OrderedSlice[T]{&ComparableSlice[T]{&Slice[T]{slice: slice}}}}
+}
+
+// Add returns the element-wise sum of the slice and v.
+// Returns ErrLengthMismatch if their lengths differ.
+func (s NumericSlice[T]) Add(v []T) (*NumericSlice[T], error) {
+	c := s.Clone()
+	if err := c.AddInPlace(v); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// AddInPlace adds v to the slice element-wise.
+// Returns ErrLengthMismatch, leaving the slice untouched, if their lengths differ.
+func (s *NumericSlice[T]) AddInPlace(v []T) error {
+	if err := s.checkLen(v); err != nil {
+		return err
+	}
+	for i := range s.slice {
+		s.slice[i] += v[i]
+	}
+	return nil
+}
+
+// Sub returns the element-wise difference of the slice and v.
+// Returns ErrLengthMismatch if their lengths differ.
+func (s NumericSlice[T]) Sub(v []T) (*NumericSlice[T], error) {
+	c := s.Clone()
+	if err := c.SubInPlace(v); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SubInPlace subtracts v from the slice element-wise.
+// Returns ErrLengthMismatch, leaving the slice untouched, if their lengths differ.
+func (s *NumericSlice[T]) SubInPlace(v []T) error {
+	if err := s.checkLen(v); err != nil {
+		return err
+	}
+	for i := range s.slice {
+		s.slice[i] -= v[i]
+	}
+	return nil
+}
+
+// Scale returns the elements multiplied by k.
+func (s NumericSlice[T]) Scale(k T) *NumericSlice[T] {
+	c := s.Clone()
+	c.ScaleInPlace(k)
+	return c
+}
+
+// ScaleInPlace multiplies every element by k.
+func (s *NumericSlice[T]) ScaleInPlace(k T) {
+	for i := range s.slice {
+		s.slice[i] *= k
+	}
+}
+
+// Dot returns the dot product of the slice and v.
+// Returns ErrLengthMismatch if their lengths differ.
+func (s NumericSlice[T]) Dot(v []T) (dot T, err error) {
+	if err = s.checkLen(v); err != nil {
+		return
+	}
+	for i, e := range s.slice {
+		dot += e * v[i]
+	}
+	return
+}
+
+// Norm returns the Euclidean norm of the slice.
+func (s NumericSlice[T]) Norm() float64 {
+	var sum float64
+	for _, v := range s.slice {
+		sum += float64(v) * float64(v)
+	}
+	return math.Sqrt(sum)
+}
+
+// Normalize returns the slice divided by its norm, as a unit vector of float64.
+// A zero vector is returned as is.
+func (s NumericSlice[T]) Normalize() *NumericSlice[float64] {
+	norm := s.Norm()
+	unit := make([]float64, len(s.slice))
+	for i, v := range s.slice {
+		unit[i] = float64(v)
+		if norm != 0 {
+			unit[i] /= norm
+		}
+	}
+	return withSlice(unit)
+}
+
+// CumSum returns the cumulative sums of the elements:
+// the i-th element is the sum of the first i+1 ones.
+func (s NumericSlice[T]) CumSum() *NumericSlice[T] {
+	c := s.Clone()
+	c.CumSumInPlace()
+	return c
+}
+
+// CumSumInPlace replaces every element with the sum of itself and the previous ones.
+func (s *NumericSlice[T]) CumSumInPlace() {
+	for i := 1; i < len(s.slice); i++ {
+		s.slice[i] += s.slice[i-1]
+	}
+}
+
+// Diff returns the differences between consecutive elements,
+// which has one element less than the slice (none when it is empty).
+func (s NumericSlice[T]) Diff() *NumericSlice[T] {
+	c := s.Clone()
+	c.DiffInPlace()
+	return c
+}
+
+// DiffInPlace replaces the elements with the differences between consecutive ones,
+// shortening the slice by one.
+func (s *NumericSlice[T]) DiffInPlace() {
+	if len(s.slice) == 0 {
+		return
+	}
+	for i := 0; i < len(s.slice)-1; i++ {
+		s.slice[i] = s.slice[i+1] - s.slice[i]
+	}
+	s.slice = s.slice[:len(s.slice)-1]
+}
+
+// Clamp returns the elements limited to the range [lo, hi].
+// Panics if lo > hi.
+func (s NumericSlice[T]) Clamp(lo, hi T) *NumericSlice[T] {
+	c := s.Clone()
+	c.ClampInPlace(lo, hi)
+	return c
+}
+
+// ClampInPlace limits every element to the range [lo, hi].
+// Panics if lo > hi.
+func (s *NumericSlice[T]) ClampInPlace(lo, hi T) {
+	if lo > hi {
+		panic("slicelib: Clamp with lo > hi")
+	}
+	for i, v := range s.slice {
+		s.slice[i] = min(max(v, lo), hi)
+	}
+}
+
+// Apply returns the result of calling f on every element.
+func (s NumericSlice[T]) Apply(f func(T) T) *NumericSlice[T] {
+	c := s.Clone()
+	c.ApplyInPlace(f)
+	return c
+}
+
+// ApplyInPlace replaces every element with the result of calling f on it.
+func (s *NumericSlice[T]) ApplyInPlace(f func(T) T) {
+	for i, v := range s.slice {
+		s.slice[i] = f(v)
+	}
+}
@@ -0,0 +1,329 @@
+package slicelib
+
+import (
+	"slices"
+)
+
+// view is a live window onto positions [off, off+len) of a parent Slicer.
+//
+// Reads and writes go through to the parent, and structural changes made
+// through the view (Append, Insert, Delete...) are applied to the parent
+// while the window grows or shrinks accordingly.
+//
+// The window is bound to positions, not to elements: if the parent is
+// structurally modified directly, the view keeps its offsets and shows
+// whatever elements end up there, and its length is clamped to the
+// elements the parent still has in the window.
+//
+// Views are created with the View method of Slice, LinkedList and IndexedSlice,
+// which panics if the range is out of bounds. A view of a view is a window
+// onto the same parent.
+type view[T any] struct {
+	parent Slicer[T]
+	off    int
+	len    int
+}
+
+// newView creates a view of the positions [i, j) of the parent.
+// Panics if the range is out of bounds.
+func newView[T any](parent Slicer[T], i, j int) *view[T] {
+	if i < 0 || j > parent.Len() || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+	return &view[T]{parent: parent, off: i, len: j - i}
+}
+
+// window returns the live elements of the view when the parent is a Slice.
+func (v *view[T]) window() ([]T, bool) {
+	s, ok := v.parent.(*Slice[T])
+	if !ok {
+		return nil, false
+	}
+	n := v.Len()
+	return s.slice[v.off : v.off+n : v.off+n], true
+}
+
+// replace swaps the contents of the window with items.
+func (v *view[T]) replace(items []T) {
+	n := v.Len()
+	v.parent.Delete(v.off, v.off+n)
+	v.parent.Insert(v.off, items...)
+	v.len = len(items)
+}
+
+// View returns a view of the positions [i, j) of this view.
+func (v *view[T]) View(i, j int) Slicer[T] {
+	if i < 0 || j > v.Len() || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+	return newView[T](v.parent, v.off+i, v.off+j)
+}
+
+// equality returns the EqualityStrategy of the parent, if any.
+func (v *view[T]) equality() EqualityStrategy[T] {
+	return equalityOf[T](v.parent)
+}
+
+// equal returns the comparison strategy of the parent.
+func (v *view[T]) equal() func(T, T) bool {
+	return strategyOr(v.equality())
+}
+
+// Len returns the number of elements in the window, clamped to the parent.
+func (v *view[T]) Len() int {
+	return max(min(v.len, v.parent.Len()-v.off), 0)
+}
+
+// InRange reports whether i is a valid position in the window.
+func (v *view[T]) InRange(i int) bool {
+	return i >= 0 && i < v.Len()
+}
+
+// IsEmpty reports whether the window has no elements.
+func (v *view[T]) IsEmpty() bool {
+	return v.Len() == 0
+}
+
+// At returns the element at position i of the window.
+func (v *view[T]) At(i int) T {
+	if !v.InRange(i) {
+		outOfRangePanic(i, v.Len())
+	}
+	return v.parent.At(v.off + i)
+}
+
+// Set replaces the element at position i of the window in the parent.
+func (v *view[T]) Set(i int, val T) {
+	if !v.InRange(i) {
+		outOfRangePanic(i, v.Len())
+	}
+	v.parent.Set(v.off+i, val)
+}
+
+// S returns the elements of the window.
+// When the parent is a Slice, the result shares its storage.
+func (v *view[T]) S() []T {
+	if w, ok := v.window(); ok {
+		return w
+	}
+
+	s := make([]T, 0, v.Len())
+	v.Range(func(_ int, t T) bool {
+		s = append(s, t)
+		return true
+	})
+	return s
+}
+
+// Range iterates over the window, with positions relative to it.
+func (v *view[T]) Range(f func(int, T) bool) {
+	end := v.off + v.Len()
+	v.parent.Range(func(i int, t T) bool {
+		if i < v.off {
+			return true
+		}
+		if i >= end {
+			return false
+		}
+		return f(i-v.off, t)
+	})
+}
+
+// ReverseRange iterates over the window from the last element.
+func (v *view[T]) ReverseRange(f func(int, T) bool) {
+	end := v.off + v.Len()
+	v.parent.ReverseRange(func(i int, t T) bool {
+		if i >= end {
+			return true
+		}
+		if i < v.off {
+			return false
+		}
+		return f(i-v.off, t)
+	})
+}
+
+// String formats the elements of the window.
+func (v *view[T]) String() string {
+	return makeString(v.Range, v.Len())
+}
+
+// Index returns the position of the first occurrence of val in the window, or -1.
+func (v *view[T]) Index(val T) (index int) {
+	index = -1
+	eq := v.equal()
+	v.Range(func(i int, t T) bool {
+		if eq(val, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// LastIndex returns the position of the last occurrence of val in the window, or -1.
+func (v *view[T]) LastIndex(val T) (index int) {
+	index = -1
+	eq := v.equal()
+	v.ReverseRange(func(i int, t T) bool {
+		if eq(val, t) {
+			index = i
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// Contains reports whether val is in the window.
+func (v *view[T]) Contains(val T) bool {
+	return v.Index(val) != -1
+}
+
+// Equal compares the window with s, using the equality of the parent.
+func (v *view[T]) Equal(s []T) bool {
+	return v.EqualFunc(s, v.equal())
+}
+
+// EqualFunc compares the window with s using f.
+func (v *view[T]) EqualFunc(s []T, f func(T, T) bool) (eq bool) {
+	if len(s) != v.Len() {
+		return false
+	}
+
+	eq = true
+	v.Range(func(i int, t T) bool {
+		eq = f(s[i], t)
+		return eq
+	})
+	return
+}
+
+// EqualSlicer compares the window with another ReadSlicer.
+func (v *view[T]) EqualSlicer(s ReadSlicer[T]) bool {
+	return v.EqualSlicerFunc(s, v.equal())
+}
+
+// EqualSlicerFunc compares the window with another ReadSlicer using f.
+func (v *view[T]) EqualSlicerFunc(s ReadSlicer[T], f func(T, T) bool) bool {
+	return equalSlicersFunc(v, s, f)
+}
+
+// Append inserts items in the parent at the end of the window, growing it.
+func (v *view[T]) Append(items ...T) {
+	n := v.Len()
+	v.parent.Insert(v.off+n, items...)
+	v.len = n + len(items)
+}
+
+// Insert inserts items in the parent at position i of the window, growing it.
+func (v *view[T]) Insert(i int, items ...T) {
+	n := v.Len()
+	if i < 0 || i > n {
+		outOfRangePanic(i, n)
+	}
+	v.parent.Insert(v.off+i, items...)
+	v.len = n + len(items)
+}
+
+// Delete removes the elements [i:j] of the window from the parent, shrinking it.
+func (v *view[T]) Delete(i, j int) {
+	n := v.Len()
+	if i < 0 || j > n || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+	v.parent.Delete(v.off+i, v.off+j)
+	v.len = n - (j - i)
+}
+
+// Pop removes the element at position i of the window from the parent.
+func (v *view[T]) Pop(i int) {
+	if !v.InRange(i) {
+		outOfRangePanic(i, v.Len())
+	}
+	v.Delete(i, i+1)
+}
+
+// Remove removes the first occurrence of val in the window from the parent.
+func (v *view[T]) Remove(val T) {
+	v.Pop(v.Index(val))
+}
+
+// RemoveLast removes the last occurrence of val in the window from the parent.
+func (v *view[T]) RemoveLast(val T) {
+	v.Pop(v.LastIndex(val))
+}
+
+// Clear removes the elements of the window from the parent.
+func (v *view[T]) Clear() {
+	v.Delete(0, v.Len())
+}
+
+// Reverse reverses the elements of the window in the parent.
+func (v *view[T]) Reverse() {
+	if w, ok := v.window(); ok {
+		slices.Reverse(w)
+		return
+	}
+
+	s := v.S()
+	slices.Reverse(s)
+	v.replace(s)
+}
+
+// SortFunc sorts the elements of the window in the parent.
+func (v *view[T]) SortFunc(f func(a, b T) int) {
+	if w, ok := v.window(); ok {
+		slices.SortFunc(w, f)
+		return
+	}
+
+	s := v.S()
+	slices.SortFunc(s, f)
+	v.replace(s)
+}
+
+// SortStableFunc sorts the elements of the window in the parent, keeping the order of equal ones.
+func (v *view[T]) SortStableFunc(f func(a, b T) int) {
+	if w, ok := v.window(); ok {
+		slices.SortStableFunc(w, f)
+		return
+	}
+
+	s := v.S()
+	slices.SortStableFunc(s, f)
+	v.replace(s)
+}
+
+// Filter removes from the parent the elements of the window for which f returns false.
+func (v *view[T]) Filter(f func(T) (pass bool)) {
+	s := v.S()
+	v.replace(slices.DeleteFunc(slices.Clone(s), func(t T) bool {
+		return !f(t)
+	}))
+}
+
+// RemoveDuplicates removes from the parent the repeated elements of the window.
+func (v *view[T]) RemoveDuplicates() {
+	v.Filter(uniqueFunc(v.equality()))
+}
+
+// SliceRight narrows the window to [:i], leaving the parent untouched.
+func (v *view[T]) SliceRight(i int) {
+	v.SliceRange(0, i)
+}
+
+// SliceLeft narrows the window to [i:], leaving the parent untouched.
+func (v *view[T]) SliceLeft(i int) {
+	v.SliceRange(i, v.Len())
+}
+
+// SliceRange narrows the window to [i:j], leaving the parent untouched.
+func (v *view[T]) SliceRange(i, j int) {
+	if i < 0 || j > v.Len() || i > j {
+		panic("slicelib: slice bounds out of range")
+	}
+	v.off += i
+	v.len = j - i
+}